@@ -0,0 +1,7047 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/relation/v1/relation.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FollowRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FollowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FollowRequestMultiError, or
+// nil if none found.
+func (m *FollowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := FollowRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFollowedUid() <= 0 {
+		err := FollowRequestValidationError{
+			field:  "FollowedUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := FollowSource_name[int32(m.GetSource())]; !ok {
+		err := FollowRequestValidationError{
+			field:  "Source",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SourceMeta
+
+	if len(errors) > 0 {
+		return FollowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowRequestMultiError is an error wrapping multiple validation errors
+// returned by FollowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FollowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowRequestMultiError) AllErrors() []error { return m }
+
+// FollowRequestValidationError is the validation error returned by
+// FollowRequest.Validate if the designated constraints aren't met.
+type FollowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowRequestValidationError) ErrorName() string { return "FollowRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FollowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowRequestValidationError{}
+
+// Validate checks the field values on FollowReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FollowReplyMultiError, or
+// nil if none found.
+func (m *FollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowReplyMultiError is an error wrapping multiple validation errors
+// returned by FollowReply.ValidateAll() if the designated constraints aren't met.
+type FollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowReplyMultiError) AllErrors() []error { return m }
+
+// FollowReplyValidationError is the validation error returned by
+// FollowReply.Validate if the designated constraints aren't met.
+type FollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowReplyValidationError) ErrorName() string { return "FollowReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowReplyValidationError{}
+
+// Validate checks the field values on UnfollowRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnfollowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnfollowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnfollowRequestMultiError, or nil if none found.
+func (m *UnfollowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnfollowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UnfollowRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFollowedUid() <= 0 {
+		err := UnfollowRequestValidationError{
+			field:  "FollowedUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnfollowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnfollowRequestMultiError is an error wrapping multiple validation errors
+// returned by UnfollowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnfollowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnfollowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnfollowRequestMultiError) AllErrors() []error { return m }
+
+// UnfollowRequestValidationError is the validation error returned by
+// UnfollowRequest.Validate if the designated constraints aren't met.
+type UnfollowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnfollowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnfollowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnfollowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnfollowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnfollowRequestValidationError) ErrorName() string { return "UnfollowRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnfollowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnfollowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnfollowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnfollowRequestValidationError{}
+
+// Validate checks the field values on UnfollowReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnfollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnfollowReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnfollowReplyMultiError, or
+// nil if none found.
+func (m *UnfollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnfollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnfollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnfollowReplyMultiError is an error wrapping multiple validation errors
+// returned by UnfollowReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnfollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnfollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnfollowReplyMultiError) AllErrors() []error { return m }
+
+// UnfollowReplyValidationError is the validation error returned by
+// UnfollowReply.Validate if the designated constraints aren't met.
+type UnfollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnfollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnfollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnfollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnfollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnfollowReplyValidationError) ErrorName() string { return "UnfollowReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnfollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnfollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnfollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnfollowReplyValidationError{}
+
+// Validate checks the field values on BatchGetRelationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRelationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRelationRequestMultiError, or nil if none found.
+func (m *BatchGetRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := BatchGetRelationRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetIds()); l < 1 || l > 100 {
+		err := BatchGetRelationRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchGetRelationRequest_Ids_Unique := make(map[int64]struct{}, len(m.GetIds()))
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if _, exists := _BatchGetRelationRequest_Ids_Unique[item]; exists {
+			err := BatchGetRelationRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchGetRelationRequest_Ids_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchGetRelationRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetRelationRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRelationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRelationRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetRelationRequestValidationError is the validation error returned by
+// BatchGetRelationRequest.Validate if the designated constraints aren't met.
+type BatchGetRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRelationRequestValidationError) ErrorName() string {
+	return "BatchGetRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRelationRequestValidationError{}
+
+// Validate checks the field values on BatchGetRelationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRelationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRelationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRelationReplyMultiError, or nil if none found.
+func (m *BatchGetRelationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRelationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	{
+		sorted_keys := make([]int64, len(m.GetAttributes()))
+		i := 0
+		for key := range m.GetAttributes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAttributes()[key]
+			_ = val
+
+			// no validation rules for Attributes[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, BatchGetRelationReplyValidationError{
+							field:  fmt.Sprintf("Attributes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, BatchGetRelationReplyValidationError{
+							field:  fmt.Sprintf("Attributes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return BatchGetRelationReplyValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchGetRelationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetRelationReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRelationReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRelationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRelationReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRelationReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetRelationReplyValidationError is the validation error returned by
+// BatchGetRelationReply.Validate if the designated constraints aren't met.
+type BatchGetRelationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRelationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRelationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRelationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRelationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRelationReplyValidationError) ErrorName() string {
+	return "BatchGetRelationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRelationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRelationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRelationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRelationReplyValidationError{}
+
+// Validate checks the field values on FollowingListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowingListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowingListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowingListRequestMultiError, or nil if none found.
+func (m *FollowingListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowingListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := FollowingListRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastId() < 0 {
+		err := FollowingListRequestValidationError{
+			field:  "LastId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 100 {
+		err := FollowingListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FollowingListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowingListRequestMultiError is an error wrapping multiple validation
+// errors returned by FollowingListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FollowingListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowingListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowingListRequestMultiError) AllErrors() []error { return m }
+
+// FollowingListRequestValidationError is the validation error returned by
+// FollowingListRequest.Validate if the designated constraints aren't met.
+type FollowingListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowingListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowingListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowingListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowingListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowingListRequestValidationError) ErrorName() string {
+	return "FollowingListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowingListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowingListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowingListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowingListRequestValidationError{}
+
+// Validate checks the field values on FollowingListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowingListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowingListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowingListReplyMultiError, or nil if none found.
+func (m *FollowingListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowingListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FollowingListReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FollowingListReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FollowingListReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FollowingListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowingListReplyMultiError is an error wrapping multiple validation errors
+// returned by FollowingListReply.ValidateAll() if the designated constraints
+// aren't met.
+type FollowingListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowingListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowingListReplyMultiError) AllErrors() []error { return m }
+
+// FollowingListReplyValidationError is the validation error returned by
+// FollowingListReply.Validate if the designated constraints aren't met.
+type FollowingListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowingListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowingListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowingListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowingListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowingListReplyValidationError) ErrorName() string {
+	return "FollowingListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowingListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowingListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowingListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowingListReplyValidationError{}
+
+// Validate checks the field values on FollowerListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowerListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowerListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowerListRequestMultiError, or nil if none found.
+func (m *FollowerListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowerListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := FollowerListRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastId() < 0 {
+		err := FollowerListRequestValidationError{
+			field:  "LastId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 100 {
+		err := FollowerListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FollowerListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowerListRequestMultiError is an error wrapping multiple validation
+// errors returned by FollowerListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FollowerListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowerListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowerListRequestMultiError) AllErrors() []error { return m }
+
+// FollowerListRequestValidationError is the validation error returned by
+// FollowerListRequest.Validate if the designated constraints aren't met.
+type FollowerListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowerListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowerListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowerListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowerListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowerListRequestValidationError) ErrorName() string {
+	return "FollowerListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowerListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowerListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowerListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowerListRequestValidationError{}
+
+// Validate checks the field values on FollowerListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FollowerListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowerListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowerListReplyMultiError, or nil if none found.
+func (m *FollowerListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowerListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FollowerListReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FollowerListReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FollowerListReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FollowerListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowerListReplyMultiError is an error wrapping multiple validation errors
+// returned by FollowerListReply.ValidateAll() if the designated constraints
+// aren't met.
+type FollowerListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowerListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowerListReplyMultiError) AllErrors() []error { return m }
+
+// FollowerListReplyValidationError is the validation error returned by
+// FollowerListReply.Validate if the designated constraints aren't met.
+type FollowerListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowerListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowerListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowerListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowerListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowerListReplyValidationError) ErrorName() string {
+	return "FollowerListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowerListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowerListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowerListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowerListReplyValidationError{}
+
+// Validate checks the field values on CommonFollowersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommonFollowersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommonFollowersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommonFollowersRequestMultiError, or nil if none found.
+func (m *CommonFollowersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommonFollowersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetViewerId() <= 0 {
+		err := CommonFollowersRequestValidationError{
+			field:  "ViewerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetId() <= 0 {
+		err := CommonFollowersRequestValidationError{
+			field:  "TargetId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := CommonFollowersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommonFollowersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommonFollowersRequestMultiError is an error wrapping multiple validation
+// errors returned by CommonFollowersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommonFollowersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommonFollowersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommonFollowersRequestMultiError) AllErrors() []error { return m }
+
+// CommonFollowersRequestValidationError is the validation error returned by
+// CommonFollowersRequest.Validate if the designated constraints aren't met.
+type CommonFollowersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommonFollowersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommonFollowersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommonFollowersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommonFollowersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommonFollowersRequestValidationError) ErrorName() string {
+	return "CommonFollowersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommonFollowersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommonFollowersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommonFollowersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommonFollowersRequestValidationError{}
+
+// Validate checks the field values on CommonFollowersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommonFollowersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommonFollowersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommonFollowersReplyMultiError, or nil if none found.
+func (m *CommonFollowersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommonFollowersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CommonFollowersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommonFollowersReplyMultiError is an error wrapping multiple validation
+// errors returned by CommonFollowersReply.ValidateAll() if the designated
+// constraints aren't met.
+type CommonFollowersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommonFollowersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommonFollowersReplyMultiError) AllErrors() []error { return m }
+
+// CommonFollowersReplyValidationError is the validation error returned by
+// CommonFollowersReply.Validate if the designated constraints aren't met.
+type CommonFollowersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommonFollowersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommonFollowersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommonFollowersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommonFollowersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommonFollowersReplyValidationError) ErrorName() string {
+	return "CommonFollowersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommonFollowersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommonFollowersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommonFollowersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommonFollowersReplyValidationError{}
+
+// Validate checks the field values on CountCommonFollowersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CountCommonFollowersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountCommonFollowersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CountCommonFollowersRequestMultiError, or nil if none found.
+func (m *CountCommonFollowersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountCommonFollowersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetViewerId() <= 0 {
+		err := CountCommonFollowersRequestValidationError{
+			field:  "ViewerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetId() <= 0 {
+		err := CountCommonFollowersRequestValidationError{
+			field:  "TargetId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CountCommonFollowersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountCommonFollowersRequestMultiError is an error wrapping multiple
+// validation errors returned by CountCommonFollowersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CountCommonFollowersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountCommonFollowersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountCommonFollowersRequestMultiError) AllErrors() []error { return m }
+
+// CountCommonFollowersRequestValidationError is the validation error returned
+// by CountCommonFollowersRequest.Validate if the designated constraints
+// aren't met.
+type CountCommonFollowersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountCommonFollowersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountCommonFollowersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountCommonFollowersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountCommonFollowersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountCommonFollowersRequestValidationError) ErrorName() string {
+	return "CountCommonFollowersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CountCommonFollowersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountCommonFollowersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountCommonFollowersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountCommonFollowersRequestValidationError{}
+
+// Validate checks the field values on CountCommonFollowersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CountCommonFollowersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountCommonFollowersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CountCommonFollowersReplyMultiError, or nil if none found.
+func (m *CountCommonFollowersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountCommonFollowersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return CountCommonFollowersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountCommonFollowersReplyMultiError is an error wrapping multiple validation
+// errors returned by CountCommonFollowersReply.ValidateAll() if the
+// designated constraints aren't met.
+type CountCommonFollowersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountCommonFollowersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountCommonFollowersReplyMultiError) AllErrors() []error { return m }
+
+// CountCommonFollowersReplyValidationError is the validation error returned by
+// CountCommonFollowersReply.Validate if the designated constraints aren't met.
+type CountCommonFollowersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountCommonFollowersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountCommonFollowersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountCommonFollowersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountCommonFollowersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountCommonFollowersReplyValidationError) ErrorName() string {
+	return "CountCommonFollowersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CountCommonFollowersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountCommonFollowersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountCommonFollowersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountCommonFollowersReplyValidationError{}
+
+// Validate checks the field values on SuggestFollowsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestFollowsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestFollowsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestFollowsRequestMultiError, or nil if none found.
+func (m *SuggestFollowsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestFollowsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := SuggestFollowsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SuggestFollowsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuggestFollowsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestFollowsRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestFollowsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestFollowsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestFollowsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestFollowsRequestMultiError) AllErrors() []error { return m }
+
+// SuggestFollowsRequestValidationError is the validation error returned by
+// SuggestFollowsRequest.Validate if the designated constraints aren't met.
+type SuggestFollowsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestFollowsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestFollowsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestFollowsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestFollowsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestFollowsRequestValidationError) ErrorName() string {
+	return "SuggestFollowsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestFollowsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestFollowsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestFollowsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestFollowsRequestValidationError{}
+
+// Validate checks the field values on SuggestFollowsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestFollowsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestFollowsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestFollowsReplyMultiError, or nil if none found.
+func (m *SuggestFollowsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestFollowsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestFollowsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestFollowsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestFollowsReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestFollowsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestFollowsReplyMultiError is an error wrapping multiple validation
+// errors returned by SuggestFollowsReply.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestFollowsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestFollowsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestFollowsReplyMultiError) AllErrors() []error { return m }
+
+// SuggestFollowsReplyValidationError is the validation error returned by
+// SuggestFollowsReply.Validate if the designated constraints aren't met.
+type SuggestFollowsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestFollowsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestFollowsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestFollowsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestFollowsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestFollowsReplyValidationError) ErrorName() string {
+	return "SuggestFollowsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestFollowsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestFollowsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestFollowsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestFollowsReplyValidationError{}
+
+// Validate checks the field values on RelationGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RelationGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationGroupMultiError, or
+// nil if none found.
+func (m *RelationGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RelationGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationGroupMultiError is an error wrapping multiple validation errors
+// returned by RelationGroup.ValidateAll() if the designated constraints
+// aren't met.
+type RelationGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationGroupMultiError) AllErrors() []error { return m }
+
+// RelationGroupValidationError is the validation error returned by
+// RelationGroup.Validate if the designated constraints aren't met.
+type RelationGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationGroupValidationError) ErrorName() string { return "RelationGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationGroupValidationError{}
+
+// Validate checks the field values on CreateRelationGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRelationGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRelationGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRelationGroupRequestMultiError, or nil if none found.
+func (m *CreateRelationGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRelationGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := CreateRelationGroupRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := CreateRelationGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRelationGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRelationGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateRelationGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRelationGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRelationGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRelationGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateRelationGroupRequestValidationError is the validation error returned
+// by CreateRelationGroupRequest.Validate if the designated constraints aren't met.
+type CreateRelationGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRelationGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRelationGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRelationGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRelationGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRelationGroupRequestValidationError) ErrorName() string {
+	return "CreateRelationGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRelationGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRelationGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRelationGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRelationGroupRequestValidationError{}
+
+// Validate checks the field values on CreateRelationGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRelationGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRelationGroupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRelationGroupReplyMultiError, or nil if none found.
+func (m *CreateRelationGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRelationGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRelationGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRelationGroupReplyValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRelationGroupReplyValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRelationGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRelationGroupReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateRelationGroupReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRelationGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRelationGroupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRelationGroupReplyMultiError) AllErrors() []error { return m }
+
+// CreateRelationGroupReplyValidationError is the validation error returned by
+// CreateRelationGroupReply.Validate if the designated constraints aren't met.
+type CreateRelationGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRelationGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRelationGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRelationGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRelationGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRelationGroupReplyValidationError) ErrorName() string {
+	return "CreateRelationGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRelationGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRelationGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRelationGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRelationGroupReplyValidationError{}
+
+// Validate checks the field values on UpdateRelationGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRelationGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRelationGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRelationGroupRequestMultiError, or nil if none found.
+func (m *UpdateRelationGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRelationGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UpdateRelationGroupRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := UpdateRelationGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := UpdateRelationGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateRelationGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRelationGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateRelationGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateRelationGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRelationGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRelationGroupRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRelationGroupRequestValidationError is the validation error returned
+// by UpdateRelationGroupRequest.Validate if the designated constraints aren't met.
+type UpdateRelationGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRelationGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRelationGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRelationGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRelationGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRelationGroupRequestValidationError) ErrorName() string {
+	return "UpdateRelationGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRelationGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRelationGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRelationGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRelationGroupRequestValidationError{}
+
+// Validate checks the field values on UpdateRelationGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRelationGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRelationGroupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRelationGroupReplyMultiError, or nil if none found.
+func (m *UpdateRelationGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRelationGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateRelationGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRelationGroupReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateRelationGroupReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRelationGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRelationGroupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRelationGroupReplyMultiError) AllErrors() []error { return m }
+
+// UpdateRelationGroupReplyValidationError is the validation error returned by
+// UpdateRelationGroupReply.Validate if the designated constraints aren't met.
+type UpdateRelationGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRelationGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRelationGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRelationGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRelationGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRelationGroupReplyValidationError) ErrorName() string {
+	return "UpdateRelationGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRelationGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRelationGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRelationGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRelationGroupReplyValidationError{}
+
+// Validate checks the field values on DeleteRelationGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRelationGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRelationGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRelationGroupRequestMultiError, or nil if none found.
+func (m *DeleteRelationGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRelationGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := DeleteRelationGroupRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := DeleteRelationGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRelationGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRelationGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteRelationGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRelationGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRelationGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRelationGroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRelationGroupRequestValidationError is the validation error returned
+// by DeleteRelationGroupRequest.Validate if the designated constraints aren't met.
+type DeleteRelationGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRelationGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRelationGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRelationGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRelationGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRelationGroupRequestValidationError) ErrorName() string {
+	return "DeleteRelationGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRelationGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRelationGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRelationGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRelationGroupRequestValidationError{}
+
+// Validate checks the field values on DeleteRelationGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRelationGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRelationGroupReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRelationGroupReplyMultiError, or nil if none found.
+func (m *DeleteRelationGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRelationGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteRelationGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRelationGroupReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteRelationGroupReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRelationGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRelationGroupReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRelationGroupReplyMultiError) AllErrors() []error { return m }
+
+// DeleteRelationGroupReplyValidationError is the validation error returned by
+// DeleteRelationGroupReply.Validate if the designated constraints aren't met.
+type DeleteRelationGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRelationGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRelationGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRelationGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRelationGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRelationGroupReplyValidationError) ErrorName() string {
+	return "DeleteRelationGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRelationGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRelationGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRelationGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRelationGroupReplyValidationError{}
+
+// Validate checks the field values on ListRelationGroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelationGroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelationGroupsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelationGroupsRequestMultiError, or nil if none found.
+func (m *ListRelationGroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelationGroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ListRelationGroupsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRelationGroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelationGroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRelationGroupsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRelationGroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelationGroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelationGroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListRelationGroupsRequestValidationError is the validation error returned by
+// ListRelationGroupsRequest.Validate if the designated constraints aren't met.
+type ListRelationGroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelationGroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelationGroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelationGroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelationGroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelationGroupsRequestValidationError) ErrorName() string {
+	return "ListRelationGroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelationGroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelationGroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelationGroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelationGroupsRequestValidationError{}
+
+// Validate checks the field values on ListRelationGroupsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRelationGroupsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRelationGroupsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRelationGroupsReplyMultiError, or nil if none found.
+func (m *ListRelationGroupsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRelationGroupsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRelationGroupsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRelationGroupsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRelationGroupsReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRelationGroupsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRelationGroupsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListRelationGroupsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListRelationGroupsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRelationGroupsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRelationGroupsReplyMultiError) AllErrors() []error { return m }
+
+// ListRelationGroupsReplyValidationError is the validation error returned by
+// ListRelationGroupsReply.Validate if the designated constraints aren't met.
+type ListRelationGroupsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRelationGroupsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRelationGroupsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRelationGroupsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRelationGroupsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRelationGroupsReplyValidationError) ErrorName() string {
+	return "ListRelationGroupsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRelationGroupsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRelationGroupsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRelationGroupsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRelationGroupsReplyValidationError{}
+
+// Validate checks the field values on AddGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddGroupMembersRequestMultiError, or nil if none found.
+func (m *AddGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := AddGroupMembersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := AddGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUids()); l < 1 || l > 100 {
+		err := AddGroupMembersRequestValidationError{
+			field:  "Uids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AddGroupMembersRequest_Uids_Unique := make(map[int64]struct{}, len(m.GetUids()))
+
+	for idx, item := range m.GetUids() {
+		_, _ = idx, item
+
+		if _, exists := _AddGroupMembersRequest_Uids_Unique[item]; exists {
+			err := AddGroupMembersRequestValidationError{
+				field:  fmt.Sprintf("Uids[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AddGroupMembersRequest_Uids_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := AddGroupMembersRequestValidationError{
+				field:  fmt.Sprintf("Uids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by AddGroupMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddGroupMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// AddGroupMembersRequestValidationError is the validation error returned by
+// AddGroupMembersRequest.Validate if the designated constraints aren't met.
+type AddGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddGroupMembersRequestValidationError) ErrorName() string {
+	return "AddGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddGroupMembersRequestValidationError{}
+
+// Validate checks the field values on AddGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddGroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddGroupMembersReplyMultiError, or nil if none found.
+func (m *AddGroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddGroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddGroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddGroupMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by AddGroupMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type AddGroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddGroupMembersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddGroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// AddGroupMembersReplyValidationError is the validation error returned by
+// AddGroupMembersReply.Validate if the designated constraints aren't met.
+type AddGroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddGroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddGroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddGroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddGroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddGroupMembersReplyValidationError) ErrorName() string {
+	return "AddGroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddGroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddGroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddGroupMembersReplyValidationError{}
+
+// Validate checks the field values on RemoveGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveGroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveGroupMembersRequestMultiError, or nil if none found.
+func (m *RemoveGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RemoveGroupMembersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := RemoveGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUids()); l < 1 || l > 100 {
+		err := RemoveGroupMembersRequestValidationError{
+			field:  "Uids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RemoveGroupMembersRequest_Uids_Unique := make(map[int64]struct{}, len(m.GetUids()))
+
+	for idx, item := range m.GetUids() {
+		_, _ = idx, item
+
+		if _, exists := _RemoveGroupMembersRequest_Uids_Unique[item]; exists {
+			err := RemoveGroupMembersRequestValidationError{
+				field:  fmt.Sprintf("Uids[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RemoveGroupMembersRequest_Uids_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := RemoveGroupMembersRequestValidationError{
+				field:  fmt.Sprintf("Uids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoveGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveGroupMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveGroupMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// RemoveGroupMembersRequestValidationError is the validation error returned by
+// RemoveGroupMembersRequest.Validate if the designated constraints aren't met.
+type RemoveGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveGroupMembersRequestValidationError) ErrorName() string {
+	return "RemoveGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveGroupMembersRequestValidationError{}
+
+// Validate checks the field values on RemoveGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveGroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveGroupMembersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveGroupMembersReplyMultiError, or nil if none found.
+func (m *RemoveGroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveGroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveGroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveGroupMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by RemoveGroupMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveGroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveGroupMembersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveGroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// RemoveGroupMembersReplyValidationError is the validation error returned by
+// RemoveGroupMembersReply.Validate if the designated constraints aren't met.
+type RemoveGroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveGroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveGroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveGroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveGroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveGroupMembersReplyValidationError) ErrorName() string {
+	return "RemoveGroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveGroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveGroupMembersReplyValidationError{}
+
+// Validate checks the field values on GroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMembersRequestMultiError, or nil if none found.
+func (m *GroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := GroupMembersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := GroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastId() < 0 {
+		err := GroupMembersRequestValidationError{
+			field:  "LastId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 100 {
+		err := GroupMembersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GroupMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// GroupMembersRequestValidationError is the validation error returned by
+// GroupMembersRequest.Validate if the designated constraints aren't met.
+type GroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMembersRequestValidationError) ErrorName() string {
+	return "GroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMembersRequestValidationError{}
+
+// Validate checks the field values on GroupMembersReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMembersReplyMultiError, or nil if none found.
+func (m *GroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupMembersReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupMembersReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupMembersReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMembersReplyMultiError is an error wrapping multiple validation errors
+// returned by GroupMembersReply.ValidateAll() if the designated constraints
+// aren't met.
+type GroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMembersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// GroupMembersReplyValidationError is the validation error returned by
+// GroupMembersReply.Validate if the designated constraints aren't met.
+type GroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMembersReplyValidationError) ErrorName() string {
+	return "GroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMembersReplyValidationError{}
+
+// Validate checks the field values on FollowAttributes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FollowAttributes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowAttributes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowAttributesMultiError, or nil if none found.
+func (m *FollowAttributes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowAttributes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Remark
+
+	// no validation rules for Special
+
+	// no validation rules for Muted
+
+	if len(errors) > 0 {
+		return FollowAttributesMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowAttributesMultiError is an error wrapping multiple validation errors
+// returned by FollowAttributes.ValidateAll() if the designated constraints
+// aren't met.
+type FollowAttributesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowAttributesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowAttributesMultiError) AllErrors() []error { return m }
+
+// FollowAttributesValidationError is the validation error returned by
+// FollowAttributes.Validate if the designated constraints aren't met.
+type FollowAttributesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowAttributesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowAttributesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowAttributesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowAttributesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowAttributesValidationError) ErrorName() string { return "FollowAttributesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FollowAttributesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowAttributes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowAttributesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowAttributesValidationError{}
+
+// Validate checks the field values on UpdateFollowAttributesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFollowAttributesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFollowAttributesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateFollowAttributesRequestMultiError, or nil if none found.
+func (m *UpdateFollowAttributesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFollowAttributesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UpdateFollowAttributesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFollowedUid() <= 0 {
+		err := UpdateFollowAttributesRequestValidationError{
+			field:  "FollowedUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Remark != nil {
+
+		if utf8.RuneCountInString(m.GetRemark()) > 32 {
+			err := UpdateFollowAttributesRequestValidationError{
+				field:  "Remark",
+				reason: "value length must be at most 32 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Special != nil {
+		// no validation rules for Special
+	}
+
+	if m.Muted != nil {
+		// no validation rules for Muted
+	}
+
+	if len(errors) > 0 {
+		return UpdateFollowAttributesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFollowAttributesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateFollowAttributesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateFollowAttributesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFollowAttributesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFollowAttributesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFollowAttributesRequestValidationError is the validation error
+// returned by UpdateFollowAttributesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateFollowAttributesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFollowAttributesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFollowAttributesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFollowAttributesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFollowAttributesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFollowAttributesRequestValidationError) ErrorName() string {
+	return "UpdateFollowAttributesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFollowAttributesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFollowAttributesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFollowAttributesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFollowAttributesRequestValidationError{}
+
+// Validate checks the field values on UpdateFollowAttributesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFollowAttributesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFollowAttributesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFollowAttributesReplyMultiError, or nil if none found.
+func (m *UpdateFollowAttributesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFollowAttributesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFollowAttributesReplyValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFollowAttributesReplyValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFollowAttributesReplyValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateFollowAttributesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFollowAttributesReplyMultiError is an error wrapping multiple
+// validation errors returned by UpdateFollowAttributesReply.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateFollowAttributesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFollowAttributesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFollowAttributesReplyMultiError) AllErrors() []error { return m }
+
+// UpdateFollowAttributesReplyValidationError is the validation error returned
+// by UpdateFollowAttributesReply.Validate if the designated constraints
+// aren't met.
+type UpdateFollowAttributesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFollowAttributesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFollowAttributesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFollowAttributesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFollowAttributesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFollowAttributesReplyValidationError) ErrorName() string {
+	return "UpdateFollowAttributesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFollowAttributesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFollowAttributesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFollowAttributesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFollowAttributesReplyValidationError{}
+
+// Validate checks the field values on AddCloseFriendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCloseFriendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCloseFriendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCloseFriendRequestMultiError, or nil if none found.
+func (m *AddCloseFriendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCloseFriendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := AddCloseFriendRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFriendUid() <= 0 {
+		err := AddCloseFriendRequestValidationError{
+			field:  "FriendUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddCloseFriendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCloseFriendRequestMultiError is an error wrapping multiple validation
+// errors returned by AddCloseFriendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddCloseFriendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCloseFriendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCloseFriendRequestMultiError) AllErrors() []error { return m }
+
+// AddCloseFriendRequestValidationError is the validation error returned by
+// AddCloseFriendRequest.Validate if the designated constraints aren't met.
+type AddCloseFriendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCloseFriendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCloseFriendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCloseFriendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCloseFriendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCloseFriendRequestValidationError) ErrorName() string {
+	return "AddCloseFriendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCloseFriendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCloseFriendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCloseFriendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCloseFriendRequestValidationError{}
+
+// Validate checks the field values on AddCloseFriendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCloseFriendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCloseFriendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCloseFriendReplyMultiError, or nil if none found.
+func (m *AddCloseFriendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCloseFriendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddCloseFriendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCloseFriendReplyMultiError is an error wrapping multiple validation
+// errors returned by AddCloseFriendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AddCloseFriendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCloseFriendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCloseFriendReplyMultiError) AllErrors() []error { return m }
+
+// AddCloseFriendReplyValidationError is the validation error returned by
+// AddCloseFriendReply.Validate if the designated constraints aren't met.
+type AddCloseFriendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCloseFriendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCloseFriendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCloseFriendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCloseFriendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCloseFriendReplyValidationError) ErrorName() string {
+	return "AddCloseFriendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCloseFriendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCloseFriendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCloseFriendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCloseFriendReplyValidationError{}
+
+// Validate checks the field values on RemoveCloseFriendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveCloseFriendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveCloseFriendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveCloseFriendRequestMultiError, or nil if none found.
+func (m *RemoveCloseFriendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveCloseFriendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RemoveCloseFriendRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFriendUid() <= 0 {
+		err := RemoveCloseFriendRequestValidationError{
+			field:  "FriendUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveCloseFriendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveCloseFriendRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveCloseFriendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveCloseFriendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveCloseFriendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveCloseFriendRequestMultiError) AllErrors() []error { return m }
+
+// RemoveCloseFriendRequestValidationError is the validation error returned by
+// RemoveCloseFriendRequest.Validate if the designated constraints aren't met.
+type RemoveCloseFriendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveCloseFriendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveCloseFriendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveCloseFriendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveCloseFriendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveCloseFriendRequestValidationError) ErrorName() string {
+	return "RemoveCloseFriendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveCloseFriendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveCloseFriendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveCloseFriendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveCloseFriendRequestValidationError{}
+
+// Validate checks the field values on RemoveCloseFriendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveCloseFriendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveCloseFriendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveCloseFriendReplyMultiError, or nil if none found.
+func (m *RemoveCloseFriendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveCloseFriendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveCloseFriendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveCloseFriendReplyMultiError is an error wrapping multiple validation
+// errors returned by RemoveCloseFriendReply.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveCloseFriendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveCloseFriendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveCloseFriendReplyMultiError) AllErrors() []error { return m }
+
+// RemoveCloseFriendReplyValidationError is the validation error returned by
+// RemoveCloseFriendReply.Validate if the designated constraints aren't met.
+type RemoveCloseFriendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveCloseFriendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveCloseFriendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveCloseFriendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveCloseFriendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveCloseFriendReplyValidationError) ErrorName() string {
+	return "RemoveCloseFriendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveCloseFriendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveCloseFriendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveCloseFriendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveCloseFriendReplyValidationError{}
+
+// Validate checks the field values on BatchIsCloseFriendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchIsCloseFriendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchIsCloseFriendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchIsCloseFriendRequestMultiError, or nil if none found.
+func (m *BatchIsCloseFriendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchIsCloseFriendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := BatchIsCloseFriendRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetIds()); l < 1 || l > 100 {
+		err := BatchIsCloseFriendRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchIsCloseFriendRequest_Ids_Unique := make(map[int64]struct{}, len(m.GetIds()))
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if _, exists := _BatchIsCloseFriendRequest_Ids_Unique[item]; exists {
+			err := BatchIsCloseFriendRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchIsCloseFriendRequest_Ids_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchIsCloseFriendRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchIsCloseFriendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchIsCloseFriendRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchIsCloseFriendRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchIsCloseFriendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchIsCloseFriendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchIsCloseFriendRequestMultiError) AllErrors() []error { return m }
+
+// BatchIsCloseFriendRequestValidationError is the validation error returned by
+// BatchIsCloseFriendRequest.Validate if the designated constraints aren't met.
+type BatchIsCloseFriendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchIsCloseFriendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchIsCloseFriendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchIsCloseFriendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchIsCloseFriendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchIsCloseFriendRequestValidationError) ErrorName() string {
+	return "BatchIsCloseFriendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchIsCloseFriendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchIsCloseFriendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchIsCloseFriendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchIsCloseFriendRequestValidationError{}
+
+// Validate checks the field values on BatchIsCloseFriendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchIsCloseFriendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchIsCloseFriendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchIsCloseFriendReplyMultiError, or nil if none found.
+func (m *BatchIsCloseFriendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchIsCloseFriendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return BatchIsCloseFriendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchIsCloseFriendReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchIsCloseFriendReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchIsCloseFriendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchIsCloseFriendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchIsCloseFriendReplyMultiError) AllErrors() []error { return m }
+
+// BatchIsCloseFriendReplyValidationError is the validation error returned by
+// BatchIsCloseFriendReply.Validate if the designated constraints aren't met.
+type BatchIsCloseFriendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchIsCloseFriendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchIsCloseFriendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchIsCloseFriendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchIsCloseFriendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchIsCloseFriendReplyValidationError) ErrorName() string {
+	return "BatchIsCloseFriendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchIsCloseFriendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchIsCloseFriendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchIsCloseFriendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchIsCloseFriendReplyValidationError{}
+
+// Validate checks the field values on ListCloseFriendsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCloseFriendsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCloseFriendsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCloseFriendsRequestMultiError, or nil if none found.
+func (m *ListCloseFriendsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCloseFriendsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ListCloseFriendsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastId() < 0 {
+		err := ListCloseFriendsRequestValidationError{
+			field:  "LastId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 100 {
+		err := ListCloseFriendsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCloseFriendsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCloseFriendsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCloseFriendsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCloseFriendsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCloseFriendsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCloseFriendsRequestMultiError) AllErrors() []error { return m }
+
+// ListCloseFriendsRequestValidationError is the validation error returned by
+// ListCloseFriendsRequest.Validate if the designated constraints aren't met.
+type ListCloseFriendsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCloseFriendsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCloseFriendsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCloseFriendsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCloseFriendsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCloseFriendsRequestValidationError) ErrorName() string {
+	return "ListCloseFriendsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCloseFriendsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCloseFriendsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCloseFriendsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCloseFriendsRequestValidationError{}
+
+// Validate checks the field values on ListCloseFriendsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCloseFriendsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCloseFriendsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCloseFriendsReplyMultiError, or nil if none found.
+func (m *ListCloseFriendsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCloseFriendsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCloseFriendsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCloseFriendsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCloseFriendsReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCloseFriendsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCloseFriendsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListCloseFriendsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListCloseFriendsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCloseFriendsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCloseFriendsReplyMultiError) AllErrors() []error { return m }
+
+// ListCloseFriendsReplyValidationError is the validation error returned by
+// ListCloseFriendsReply.Validate if the designated constraints aren't met.
+type ListCloseFriendsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCloseFriendsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCloseFriendsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCloseFriendsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCloseFriendsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCloseFriendsReplyValidationError) ErrorName() string {
+	return "ListCloseFriendsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCloseFriendsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCloseFriendsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCloseFriendsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCloseFriendsReplyValidationError{}
+
+// Validate checks the field values on FollowSourceStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowSourceStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowSourceStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowSourceStatsRequestMultiError, or nil if none found.
+func (m *FollowSourceStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowSourceStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_FollowSourceStatsRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+		err := FollowSourceStatsRequestValidationError{
+			field:  "StartDate",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_FollowSourceStatsRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+		err := FollowSourceStatsRequestValidationError{
+			field:  "EndDate",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Source != nil {
+
+		if _, ok := FollowSource_name[int32(m.GetSource())]; !ok {
+			err := FollowSourceStatsRequestValidationError{
+				field:  "Source",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FollowSourceStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowSourceStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by FollowSourceStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FollowSourceStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowSourceStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowSourceStatsRequestMultiError) AllErrors() []error { return m }
+
+// FollowSourceStatsRequestValidationError is the validation error returned by
+// FollowSourceStatsRequest.Validate if the designated constraints aren't met.
+type FollowSourceStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowSourceStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowSourceStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowSourceStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowSourceStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowSourceStatsRequestValidationError) ErrorName() string {
+	return "FollowSourceStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowSourceStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowSourceStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowSourceStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowSourceStatsRequestValidationError{}
+
+var _FollowSourceStatsRequest_StartDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _FollowSourceStatsRequest_EndDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on FollowSourceStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowSourceStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowSourceStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowSourceStatsReplyMultiError, or nil if none found.
+func (m *FollowSourceStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowSourceStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FollowSourceStatsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FollowSourceStatsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FollowSourceStatsReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FollowSourceStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowSourceStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by FollowSourceStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type FollowSourceStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowSourceStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowSourceStatsReplyMultiError) AllErrors() []error { return m }
+
+// FollowSourceStatsReplyValidationError is the validation error returned by
+// FollowSourceStatsReply.Validate if the designated constraints aren't met.
+type FollowSourceStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowSourceStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowSourceStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowSourceStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowSourceStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowSourceStatsReplyValidationError) ErrorName() string {
+	return "FollowSourceStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowSourceStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowSourceStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowSourceStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowSourceStatsReplyValidationError{}
+
+// Validate checks the field values on TopGrowingAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopGrowingAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopGrowingAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopGrowingAccountsRequestMultiError, or nil if none found.
+func (m *TopGrowingAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopGrowingAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := GrowthWindow_name[int32(m.GetWindow())]; !ok {
+		err := TopGrowingAccountsRequestValidationError{
+			field:  "Window",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := TopGrowingAccountsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TopGrowingAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopGrowingAccountsRequestMultiError is an error wrapping multiple validation
+// errors returned by TopGrowingAccountsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type TopGrowingAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopGrowingAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopGrowingAccountsRequestMultiError) AllErrors() []error { return m }
+
+// TopGrowingAccountsRequestValidationError is the validation error returned by
+// TopGrowingAccountsRequest.Validate if the designated constraints aren't met.
+type TopGrowingAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopGrowingAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopGrowingAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopGrowingAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopGrowingAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopGrowingAccountsRequestValidationError) ErrorName() string {
+	return "TopGrowingAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopGrowingAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopGrowingAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopGrowingAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopGrowingAccountsRequestValidationError{}
+
+// Validate checks the field values on TopGrowingAccountsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopGrowingAccountsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopGrowingAccountsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TopGrowingAccountsReplyMultiError, or nil if none found.
+func (m *TopGrowingAccountsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopGrowingAccountsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TopGrowingAccountsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TopGrowingAccountsReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TopGrowingAccountsReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TopGrowingAccountsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopGrowingAccountsReplyMultiError is an error wrapping multiple validation
+// errors returned by TopGrowingAccountsReply.ValidateAll() if the designated
+// constraints aren't met.
+type TopGrowingAccountsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopGrowingAccountsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopGrowingAccountsReplyMultiError) AllErrors() []error { return m }
+
+// TopGrowingAccountsReplyValidationError is the validation error returned by
+// TopGrowingAccountsReply.Validate if the designated constraints aren't met.
+type TopGrowingAccountsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopGrowingAccountsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopGrowingAccountsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopGrowingAccountsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopGrowingAccountsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopGrowingAccountsReplyValidationError) ErrorName() string {
+	return "TopGrowingAccountsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopGrowingAccountsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopGrowingAccountsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopGrowingAccountsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopGrowingAccountsReplyValidationError{}
+
+// Validate checks the field values on FollowerTimeSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowerTimeSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowerTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowerTimeSeriesRequestMultiError, or nil if none found.
+func (m *FollowerTimeSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowerTimeSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := FollowerTimeSeriesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_FollowerTimeSeriesRequest_From_Pattern.MatchString(m.GetFrom()) {
+		err := FollowerTimeSeriesRequestValidationError{
+			field:  "From",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_FollowerTimeSeriesRequest_To_Pattern.MatchString(m.GetTo()) {
+		err := FollowerTimeSeriesRequestValidationError{
+			field:  "To",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TimeSeriesGranularity_name[int32(m.GetGranularity())]; !ok {
+		err := FollowerTimeSeriesRequestValidationError{
+			field:  "Granularity",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FollowerTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowerTimeSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by FollowerTimeSeriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type FollowerTimeSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowerTimeSeriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowerTimeSeriesRequestMultiError) AllErrors() []error { return m }
+
+// FollowerTimeSeriesRequestValidationError is the validation error returned by
+// FollowerTimeSeriesRequest.Validate if the designated constraints aren't met.
+type FollowerTimeSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowerTimeSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowerTimeSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowerTimeSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowerTimeSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowerTimeSeriesRequestValidationError) ErrorName() string {
+	return "FollowerTimeSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowerTimeSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowerTimeSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowerTimeSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowerTimeSeriesRequestValidationError{}
+
+var _FollowerTimeSeriesRequest_From_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _FollowerTimeSeriesRequest_To_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on FollowerTimeSeriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowerTimeSeriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowerTimeSeriesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowerTimeSeriesReplyMultiError, or nil if none found.
+func (m *FollowerTimeSeriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowerTimeSeriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FollowerTimeSeriesReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FollowerTimeSeriesReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FollowerTimeSeriesReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FollowerTimeSeriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowerTimeSeriesReplyMultiError is an error wrapping multiple validation
+// errors returned by FollowerTimeSeriesReply.ValidateAll() if the designated
+// constraints aren't met.
+type FollowerTimeSeriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowerTimeSeriesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowerTimeSeriesReplyMultiError) AllErrors() []error { return m }
+
+// FollowerTimeSeriesReplyValidationError is the validation error returned by
+// FollowerTimeSeriesReply.Validate if the designated constraints aren't met.
+type FollowerTimeSeriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowerTimeSeriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowerTimeSeriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowerTimeSeriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowerTimeSeriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowerTimeSeriesReplyValidationError) ErrorName() string {
+	return "FollowerTimeSeriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowerTimeSeriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowerTimeSeriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowerTimeSeriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowerTimeSeriesReplyValidationError{}
+
+// Validate checks the field values on FollowingListReplyUserFollow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowingListReplyUserFollow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowingListReplyUserFollow with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowingListReplyUserFollowMultiError, or nil if none found.
+func (m *FollowingListReplyUserFollow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowingListReplyUserFollow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FollowedUid
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FollowingListReplyUserFollowValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FollowingListReplyUserFollowValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FollowingListReplyUserFollowValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FollowingListReplyUserFollowMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowingListReplyUserFollowMultiError is an error wrapping multiple
+// validation errors returned by FollowingListReplyUserFollow.ValidateAll() if
+// the designated constraints aren't met.
+type FollowingListReplyUserFollowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowingListReplyUserFollowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowingListReplyUserFollowMultiError) AllErrors() []error { return m }
+
+// FollowingListReplyUserFollowValidationError is the validation error returned
+// by FollowingListReplyUserFollow.Validate if the designated constraints
+// aren't met.
+type FollowingListReplyUserFollowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowingListReplyUserFollowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowingListReplyUserFollowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowingListReplyUserFollowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowingListReplyUserFollowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowingListReplyUserFollowValidationError) ErrorName() string {
+	return "FollowingListReplyUserFollowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowingListReplyUserFollowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowingListReplyUserFollow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowingListReplyUserFollowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowingListReplyUserFollowValidationError{}
+
+// Validate checks the field values on FollowerListReplyFollower with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowerListReplyFollower) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowerListReplyFollower with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowerListReplyFollowerMultiError, or nil if none found.
+func (m *FollowerListReplyFollower) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowerListReplyFollower) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FollowerUid
+
+	if len(errors) > 0 {
+		return FollowerListReplyFollowerMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowerListReplyFollowerMultiError is an error wrapping multiple validation
+// errors returned by FollowerListReplyFollower.ValidateAll() if the
+// designated constraints aren't met.
+type FollowerListReplyFollowerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowerListReplyFollowerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowerListReplyFollowerMultiError) AllErrors() []error { return m }
+
+// FollowerListReplyFollowerValidationError is the validation error returned by
+// FollowerListReplyFollower.Validate if the designated constraints aren't met.
+type FollowerListReplyFollowerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowerListReplyFollowerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowerListReplyFollowerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowerListReplyFollowerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowerListReplyFollowerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowerListReplyFollowerValidationError) ErrorName() string {
+	return "FollowerListReplyFollowerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowerListReplyFollowerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowerListReplyFollower.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowerListReplyFollowerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowerListReplyFollowerValidationError{}
+
+// Validate checks the field values on SuggestFollowsReplySuggestion with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestFollowsReplySuggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestFollowsReplySuggestion with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SuggestFollowsReplySuggestionMultiError, or nil if none found.
+func (m *SuggestFollowsReplySuggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestFollowsReplySuggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for MutualCount
+
+	if len(errors) > 0 {
+		return SuggestFollowsReplySuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestFollowsReplySuggestionMultiError is an error wrapping multiple
+// validation errors returned by SuggestFollowsReplySuggestion.ValidateAll()
+// if the designated constraints aren't met.
+type SuggestFollowsReplySuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestFollowsReplySuggestionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestFollowsReplySuggestionMultiError) AllErrors() []error { return m }
+
+// SuggestFollowsReplySuggestionValidationError is the validation error
+// returned by SuggestFollowsReplySuggestion.Validate if the designated
+// constraints aren't met.
+type SuggestFollowsReplySuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestFollowsReplySuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestFollowsReplySuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestFollowsReplySuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestFollowsReplySuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestFollowsReplySuggestionValidationError) ErrorName() string {
+	return "SuggestFollowsReplySuggestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestFollowsReplySuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestFollowsReplySuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestFollowsReplySuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestFollowsReplySuggestionValidationError{}
+
+// Validate checks the field values on GroupMembersReplyMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GroupMembersReplyMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMembersReplyMember with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMembersReplyMemberMultiError, or nil if none found.
+func (m *GroupMembersReplyMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMembersReplyMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FollowedUid
+
+	if len(errors) > 0 {
+		return GroupMembersReplyMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMembersReplyMemberMultiError is an error wrapping multiple validation
+// errors returned by GroupMembersReplyMember.ValidateAll() if the designated
+// constraints aren't met.
+type GroupMembersReplyMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMembersReplyMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMembersReplyMemberMultiError) AllErrors() []error { return m }
+
+// GroupMembersReplyMemberValidationError is the validation error returned by
+// GroupMembersReplyMember.Validate if the designated constraints aren't met.
+type GroupMembersReplyMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMembersReplyMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMembersReplyMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMembersReplyMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMembersReplyMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMembersReplyMemberValidationError) ErrorName() string {
+	return "GroupMembersReplyMemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GroupMembersReplyMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMembersReplyMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMembersReplyMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMembersReplyMemberValidationError{}
+
+// Validate checks the field values on ListCloseFriendsReplyCloseFriend with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListCloseFriendsReplyCloseFriend) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCloseFriendsReplyCloseFriend with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListCloseFriendsReplyCloseFriendMultiError, or nil if none found.
+func (m *ListCloseFriendsReplyCloseFriend) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCloseFriendsReplyCloseFriend) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FriendUid
+
+	if len(errors) > 0 {
+		return ListCloseFriendsReplyCloseFriendMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCloseFriendsReplyCloseFriendMultiError is an error wrapping multiple
+// validation errors returned by
+// ListCloseFriendsReplyCloseFriend.ValidateAll() if the designated
+// constraints aren't met.
+type ListCloseFriendsReplyCloseFriendMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCloseFriendsReplyCloseFriendMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCloseFriendsReplyCloseFriendMultiError) AllErrors() []error { return m }
+
+// ListCloseFriendsReplyCloseFriendValidationError is the validation error
+// returned by ListCloseFriendsReplyCloseFriend.Validate if the designated
+// constraints aren't met.
+type ListCloseFriendsReplyCloseFriendValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCloseFriendsReplyCloseFriendValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCloseFriendsReplyCloseFriendValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCloseFriendsReplyCloseFriendValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCloseFriendsReplyCloseFriendValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCloseFriendsReplyCloseFriendValidationError) ErrorName() string {
+	return "ListCloseFriendsReplyCloseFriendValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCloseFriendsReplyCloseFriendValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCloseFriendsReplyCloseFriend.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCloseFriendsReplyCloseFriendValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCloseFriendsReplyCloseFriendValidationError{}
+
+// Validate checks the field values on FollowSourceStatsReplyStat with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowSourceStatsReplyStat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowSourceStatsReplyStat with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowSourceStatsReplyStatMultiError, or nil if none found.
+func (m *FollowSourceStatsReplyStat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowSourceStatsReplyStat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Source
+
+	// no validation rules for FollowCount
+
+	// no validation rules for UnfollowCount
+
+	if len(errors) > 0 {
+		return FollowSourceStatsReplyStatMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowSourceStatsReplyStatMultiError is an error wrapping multiple
+// validation errors returned by FollowSourceStatsReplyStat.ValidateAll() if
+// the designated constraints aren't met.
+type FollowSourceStatsReplyStatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowSourceStatsReplyStatMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowSourceStatsReplyStatMultiError) AllErrors() []error { return m }
+
+// FollowSourceStatsReplyStatValidationError is the validation error returned
+// by FollowSourceStatsReplyStat.Validate if the designated constraints aren't met.
+type FollowSourceStatsReplyStatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowSourceStatsReplyStatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowSourceStatsReplyStatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowSourceStatsReplyStatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowSourceStatsReplyStatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowSourceStatsReplyStatValidationError) ErrorName() string {
+	return "FollowSourceStatsReplyStatValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowSourceStatsReplyStatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowSourceStatsReplyStat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowSourceStatsReplyStatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowSourceStatsReplyStatValidationError{}
+
+// Validate checks the field values on TopGrowingAccountsReplyAccount with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TopGrowingAccountsReplyAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopGrowingAccountsReplyAccount with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TopGrowingAccountsReplyAccountMultiError, or nil if none found.
+func (m *TopGrowingAccountsReplyAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopGrowingAccountsReplyAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for Growth
+
+	if len(errors) > 0 {
+		return TopGrowingAccountsReplyAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// TopGrowingAccountsReplyAccountMultiError is an error wrapping multiple
+// validation errors returned by TopGrowingAccountsReplyAccount.ValidateAll()
+// if the designated constraints aren't met.
+type TopGrowingAccountsReplyAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopGrowingAccountsReplyAccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopGrowingAccountsReplyAccountMultiError) AllErrors() []error { return m }
+
+// TopGrowingAccountsReplyAccountValidationError is the validation error
+// returned by TopGrowingAccountsReplyAccount.Validate if the designated
+// constraints aren't met.
+type TopGrowingAccountsReplyAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopGrowingAccountsReplyAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopGrowingAccountsReplyAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopGrowingAccountsReplyAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopGrowingAccountsReplyAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopGrowingAccountsReplyAccountValidationError) ErrorName() string {
+	return "TopGrowingAccountsReplyAccountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TopGrowingAccountsReplyAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopGrowingAccountsReplyAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopGrowingAccountsReplyAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopGrowingAccountsReplyAccountValidationError{}
+
+// Validate checks the field values on FollowerTimeSeriesReplyPoint with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FollowerTimeSeriesReplyPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FollowerTimeSeriesReplyPoint with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FollowerTimeSeriesReplyPointMultiError, or nil if none found.
+func (m *FollowerTimeSeriesReplyPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FollowerTimeSeriesReplyPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for FollowerCount
+
+	// no validation rules for FollowingCount
+
+	// no validation rules for FollowerGain
+
+	// no validation rules for FollowerLoss
+
+	if len(errors) > 0 {
+		return FollowerTimeSeriesReplyPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// FollowerTimeSeriesReplyPointMultiError is an error wrapping multiple
+// validation errors returned by FollowerTimeSeriesReplyPoint.ValidateAll() if
+// the designated constraints aren't met.
+type FollowerTimeSeriesReplyPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FollowerTimeSeriesReplyPointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FollowerTimeSeriesReplyPointMultiError) AllErrors() []error { return m }
+
+// FollowerTimeSeriesReplyPointValidationError is the validation error returned
+// by FollowerTimeSeriesReplyPoint.Validate if the designated constraints
+// aren't met.
+type FollowerTimeSeriesReplyPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FollowerTimeSeriesReplyPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FollowerTimeSeriesReplyPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FollowerTimeSeriesReplyPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FollowerTimeSeriesReplyPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FollowerTimeSeriesReplyPointValidationError) ErrorName() string {
+	return "FollowerTimeSeriesReplyPointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FollowerTimeSeriesReplyPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFollowerTimeSeriesReplyPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FollowerTimeSeriesReplyPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FollowerTimeSeriesReplyPointValidationError{}
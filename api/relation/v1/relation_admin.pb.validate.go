@@ -0,0 +1,2765 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/relation/v1/relation_admin.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RawFollowingEdge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RawFollowingEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RawFollowingEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RawFollowingEdgeMultiError, or nil if none found.
+func (m *RawFollowingEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RawFollowingEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for FollowedUid
+
+	// no validation rules for Status
+
+	// no validation rules for Remark
+
+	// no validation rules for IsSpecial
+
+	// no validation rules for IsMuted
+
+	// no validation rules for Source
+
+	// no validation rules for SourceMeta
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RawFollowingEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RawFollowingEdgeMultiError is an error wrapping multiple validation errors
+// returned by RawFollowingEdge.ValidateAll() if the designated constraints
+// aren't met.
+type RawFollowingEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RawFollowingEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RawFollowingEdgeMultiError) AllErrors() []error { return m }
+
+// RawFollowingEdgeValidationError is the validation error returned by
+// RawFollowingEdge.Validate if the designated constraints aren't met.
+type RawFollowingEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RawFollowingEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RawFollowingEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RawFollowingEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RawFollowingEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RawFollowingEdgeValidationError) ErrorName() string { return "RawFollowingEdgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RawFollowingEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRawFollowingEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RawFollowingEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RawFollowingEdgeValidationError{}
+
+// Validate checks the field values on RawFollowerEdge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RawFollowerEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RawFollowerEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RawFollowerEdgeMultiError, or nil if none found.
+func (m *RawFollowerEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RawFollowerEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for FollowerUid
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return RawFollowerEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RawFollowerEdgeMultiError is an error wrapping multiple validation errors
+// returned by RawFollowerEdge.ValidateAll() if the designated constraints
+// aren't met.
+type RawFollowerEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RawFollowerEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RawFollowerEdgeMultiError) AllErrors() []error { return m }
+
+// RawFollowerEdgeValidationError is the validation error returned by
+// RawFollowerEdge.Validate if the designated constraints aren't met.
+type RawFollowerEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RawFollowerEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RawFollowerEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RawFollowerEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RawFollowerEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RawFollowerEdgeValidationError) ErrorName() string { return "RawFollowerEdgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RawFollowerEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRawFollowerEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RawFollowerEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RawFollowerEdgeValidationError{}
+
+// Validate checks the field values on GetRawEdgesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRawEdgesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRawEdgesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRawEdgesRequestMultiError, or nil if none found.
+func (m *GetRawEdgesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRawEdgesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := GetRawEdgesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetUid() <= 0 {
+		err := GetRawEdgesRequestValidationError{
+			field:  "TargetUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRawEdgesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRawEdgesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRawEdgesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRawEdgesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRawEdgesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRawEdgesRequestMultiError) AllErrors() []error { return m }
+
+// GetRawEdgesRequestValidationError is the validation error returned by
+// GetRawEdgesRequest.Validate if the designated constraints aren't met.
+type GetRawEdgesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRawEdgesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRawEdgesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRawEdgesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRawEdgesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRawEdgesRequestValidationError) ErrorName() string {
+	return "GetRawEdgesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRawEdgesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRawEdgesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRawEdgesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRawEdgesRequestValidationError{}
+
+// Validate checks the field values on GetRawEdgesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRawEdgesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRawEdgesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRawEdgesReplyMultiError, or nil if none found.
+func (m *GetRawEdgesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRawEdgesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFollowing() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRawEdgesReplyValidationError{
+						field:  fmt.Sprintf("Following[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRawEdgesReplyValidationError{
+						field:  fmt.Sprintf("Following[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRawEdgesReplyValidationError{
+					field:  fmt.Sprintf("Following[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFollower() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRawEdgesReplyValidationError{
+						field:  fmt.Sprintf("Follower[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRawEdgesReplyValidationError{
+						field:  fmt.Sprintf("Follower[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRawEdgesReplyValidationError{
+					field:  fmt.Sprintf("Follower[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRawEdgesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRawEdgesReplyMultiError is an error wrapping multiple validation errors
+// returned by GetRawEdgesReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetRawEdgesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRawEdgesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRawEdgesReplyMultiError) AllErrors() []error { return m }
+
+// GetRawEdgesReplyValidationError is the validation error returned by
+// GetRawEdgesReply.Validate if the designated constraints aren't met.
+type GetRawEdgesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRawEdgesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRawEdgesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRawEdgesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRawEdgesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRawEdgesReplyValidationError) ErrorName() string { return "GetRawEdgesReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRawEdgesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRawEdgesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRawEdgesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRawEdgesReplyValidationError{}
+
+// Validate checks the field values on ForceFollowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceFollowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceFollowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceFollowRequestMultiError, or nil if none found.
+func (m *ForceFollowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceFollowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ForceFollowRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFollowedUid() <= 0 {
+		err := ForceFollowRequestValidationError{
+			field:  "FollowedUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := ForceFollowRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ForceFollowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForceFollowRequestMultiError is an error wrapping multiple validation errors
+// returned by ForceFollowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ForceFollowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceFollowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForceFollowRequestMultiError) AllErrors() []error { return m }
+
+// ForceFollowRequestValidationError is the validation error returned by
+// ForceFollowRequest.Validate if the designated constraints aren't met.
+type ForceFollowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceFollowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceFollowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceFollowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceFollowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceFollowRequestValidationError) ErrorName() string {
+	return "ForceFollowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceFollowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceFollowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceFollowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceFollowRequestValidationError{}
+
+// Validate checks the field values on ForceFollowReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ForceFollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceFollowReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceFollowReplyMultiError, or nil if none found.
+func (m *ForceFollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceFollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ForceFollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForceFollowReplyMultiError is an error wrapping multiple validation errors
+// returned by ForceFollowReply.ValidateAll() if the designated constraints
+// aren't met.
+type ForceFollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceFollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForceFollowReplyMultiError) AllErrors() []error { return m }
+
+// ForceFollowReplyValidationError is the validation error returned by
+// ForceFollowReply.Validate if the designated constraints aren't met.
+type ForceFollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceFollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceFollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceFollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceFollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceFollowReplyValidationError) ErrorName() string { return "ForceFollowReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ForceFollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceFollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceFollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceFollowReplyValidationError{}
+
+// Validate checks the field values on ForceUnfollowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceUnfollowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceUnfollowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceUnfollowRequestMultiError, or nil if none found.
+func (m *ForceUnfollowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceUnfollowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ForceUnfollowRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFollowedUid() <= 0 {
+		err := ForceUnfollowRequestValidationError{
+			field:  "FollowedUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := ForceUnfollowRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ForceUnfollowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForceUnfollowRequestMultiError is an error wrapping multiple validation
+// errors returned by ForceUnfollowRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForceUnfollowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceUnfollowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForceUnfollowRequestMultiError) AllErrors() []error { return m }
+
+// ForceUnfollowRequestValidationError is the validation error returned by
+// ForceUnfollowRequest.Validate if the designated constraints aren't met.
+type ForceUnfollowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceUnfollowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceUnfollowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceUnfollowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceUnfollowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceUnfollowRequestValidationError) ErrorName() string {
+	return "ForceUnfollowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceUnfollowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceUnfollowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceUnfollowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceUnfollowRequestValidationError{}
+
+// Validate checks the field values on ForceUnfollowReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceUnfollowReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceUnfollowReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceUnfollowReplyMultiError, or nil if none found.
+func (m *ForceUnfollowReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceUnfollowReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ForceUnfollowReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForceUnfollowReplyMultiError is an error wrapping multiple validation errors
+// returned by ForceUnfollowReply.ValidateAll() if the designated constraints
+// aren't met.
+type ForceUnfollowReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceUnfollowReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForceUnfollowReplyMultiError) AllErrors() []error { return m }
+
+// ForceUnfollowReplyValidationError is the validation error returned by
+// ForceUnfollowReply.Validate if the designated constraints aren't met.
+type ForceUnfollowReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceUnfollowReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceUnfollowReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceUnfollowReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceUnfollowReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceUnfollowReplyValidationError) ErrorName() string {
+	return "ForceUnfollowReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceUnfollowReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceUnfollowReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceUnfollowReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceUnfollowReplyValidationError{}
+
+// Validate checks the field values on RecomputeUserCountersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecomputeUserCountersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecomputeUserCountersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecomputeUserCountersRequestMultiError, or nil if none found.
+func (m *RecomputeUserCountersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecomputeUserCountersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RecomputeUserCountersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecomputeUserCountersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecomputeUserCountersRequestMultiError is an error wrapping multiple
+// validation errors returned by RecomputeUserCountersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RecomputeUserCountersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecomputeUserCountersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecomputeUserCountersRequestMultiError) AllErrors() []error { return m }
+
+// RecomputeUserCountersRequestValidationError is the validation error returned
+// by RecomputeUserCountersRequest.Validate if the designated constraints
+// aren't met.
+type RecomputeUserCountersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecomputeUserCountersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecomputeUserCountersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecomputeUserCountersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecomputeUserCountersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecomputeUserCountersRequestValidationError) ErrorName() string {
+	return "RecomputeUserCountersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecomputeUserCountersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecomputeUserCountersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecomputeUserCountersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecomputeUserCountersRequestValidationError{}
+
+// Validate checks the field values on RecomputeUserCountersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecomputeUserCountersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecomputeUserCountersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecomputeUserCountersReplyMultiError, or nil if none found.
+func (m *RecomputeUserCountersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecomputeUserCountersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FollowingCount
+
+	// no validation rules for FollowerCount
+
+	if len(errors) > 0 {
+		return RecomputeUserCountersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecomputeUserCountersReplyMultiError is an error wrapping multiple
+// validation errors returned by RecomputeUserCountersReply.ValidateAll() if
+// the designated constraints aren't met.
+type RecomputeUserCountersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecomputeUserCountersReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecomputeUserCountersReplyMultiError) AllErrors() []error { return m }
+
+// RecomputeUserCountersReplyValidationError is the validation error returned
+// by RecomputeUserCountersReply.Validate if the designated constraints aren't met.
+type RecomputeUserCountersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecomputeUserCountersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecomputeUserCountersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecomputeUserCountersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecomputeUserCountersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecomputeUserCountersReplyValidationError) ErrorName() string {
+	return "RecomputeUserCountersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecomputeUserCountersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecomputeUserCountersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecomputeUserCountersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecomputeUserCountersReplyValidationError{}
+
+// Validate checks the field values on PurgeUserCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeUserCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeUserCacheRequestMultiError, or nil if none found.
+func (m *PurgeUserCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeUserCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := PurgeUserCacheRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgeUserCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeUserCacheRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeUserCacheRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeUserCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeUserCacheRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeUserCacheRequestMultiError) AllErrors() []error { return m }
+
+// PurgeUserCacheRequestValidationError is the validation error returned by
+// PurgeUserCacheRequest.Validate if the designated constraints aren't met.
+type PurgeUserCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeUserCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeUserCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeUserCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeUserCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeUserCacheRequestValidationError) ErrorName() string {
+	return "PurgeUserCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeUserCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeUserCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeUserCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeUserCacheRequestValidationError{}
+
+// Validate checks the field values on PurgeUserCacheReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeUserCacheReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeUserCacheReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeUserCacheReplyMultiError, or nil if none found.
+func (m *PurgeUserCacheReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeUserCacheReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FollowingCount
+
+	// no validation rules for FollowerCount
+
+	// no validation rules for CloseFriendCount
+
+	if len(errors) > 0 {
+		return PurgeUserCacheReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeUserCacheReplyMultiError is an error wrapping multiple validation
+// errors returned by PurgeUserCacheReply.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeUserCacheReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeUserCacheReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeUserCacheReplyMultiError) AllErrors() []error { return m }
+
+// PurgeUserCacheReplyValidationError is the validation error returned by
+// PurgeUserCacheReply.Validate if the designated constraints aren't met.
+type PurgeUserCacheReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeUserCacheReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeUserCacheReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeUserCacheReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeUserCacheReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeUserCacheReplyValidationError) ErrorName() string {
+	return "PurgeUserCacheReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeUserCacheReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeUserCacheReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeUserCacheReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeUserCacheReplyValidationError{}
+
+// Validate checks the field values on MergeUserRelationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeUserRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeUserRelationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeUserRelationsRequestMultiError, or nil if none found.
+func (m *MergeUserRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeUserRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFromUid() <= 0 {
+		err := MergeUserRelationsRequestValidationError{
+			field:  "FromUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToUid() <= 0 {
+		err := MergeUserRelationsRequestValidationError{
+			field:  "ToUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := MergeUserRelationsRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeUserRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeUserRelationsRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeUserRelationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type MergeUserRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeUserRelationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeUserRelationsRequestMultiError) AllErrors() []error { return m }
+
+// MergeUserRelationsRequestValidationError is the validation error returned by
+// MergeUserRelationsRequest.Validate if the designated constraints aren't met.
+type MergeUserRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeUserRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeUserRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeUserRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeUserRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeUserRelationsRequestValidationError) ErrorName() string {
+	return "MergeUserRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeUserRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeUserRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeUserRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeUserRelationsRequestValidationError{}
+
+// Validate checks the field values on MergeUserRelationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeUserRelationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeUserRelationsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeUserRelationsReplyMultiError, or nil if none found.
+func (m *MergeUserRelationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeUserRelationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MergeId
+
+	if len(errors) > 0 {
+		return MergeUserRelationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeUserRelationsReplyMultiError is an error wrapping multiple validation
+// errors returned by MergeUserRelationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type MergeUserRelationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeUserRelationsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeUserRelationsReplyMultiError) AllErrors() []error { return m }
+
+// MergeUserRelationsReplyValidationError is the validation error returned by
+// MergeUserRelationsReply.Validate if the designated constraints aren't met.
+type MergeUserRelationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeUserRelationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeUserRelationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeUserRelationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeUserRelationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeUserRelationsReplyValidationError) ErrorName() string {
+	return "MergeUserRelationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeUserRelationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeUserRelationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeUserRelationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeUserRelationsReplyValidationError{}
+
+// Validate checks the field values on UserRelationMerge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserRelationMerge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserRelationMerge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserRelationMergeMultiError, or nil if none found.
+func (m *UserRelationMerge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserRelationMerge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FromUid
+
+	// no validation rules for ToUid
+
+	// no validation rules for Status
+
+	// no validation rules for Phase
+
+	// no validation rules for Removed
+
+	// no validation rules for Transferred
+
+	// no validation rules for Duplicates
+
+	// no validation rules for OverLimit
+
+	// no validation rules for Unavailable
+
+	// no validation rules for Reason
+
+	// no validation rules for Error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for FinishedAt
+
+	if len(errors) > 0 {
+		return UserRelationMergeMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserRelationMergeMultiError is an error wrapping multiple validation errors
+// returned by UserRelationMerge.ValidateAll() if the designated constraints
+// aren't met.
+type UserRelationMergeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserRelationMergeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserRelationMergeMultiError) AllErrors() []error { return m }
+
+// UserRelationMergeValidationError is the validation error returned by
+// UserRelationMerge.Validate if the designated constraints aren't met.
+type UserRelationMergeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserRelationMergeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserRelationMergeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserRelationMergeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserRelationMergeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserRelationMergeValidationError) ErrorName() string {
+	return "UserRelationMergeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserRelationMergeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserRelationMerge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserRelationMergeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserRelationMergeValidationError{}
+
+// Validate checks the field values on GetUserRelationMergeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserRelationMergeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRelationMergeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserRelationMergeRequestMultiError, or nil if none found.
+func (m *GetUserRelationMergeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRelationMergeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMergeId() <= 0 {
+		err := GetUserRelationMergeRequestValidationError{
+			field:  "MergeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRelationMergeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRelationMergeRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserRelationMergeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserRelationMergeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRelationMergeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRelationMergeRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRelationMergeRequestValidationError is the validation error returned
+// by GetUserRelationMergeRequest.Validate if the designated constraints
+// aren't met.
+type GetUserRelationMergeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRelationMergeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRelationMergeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRelationMergeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRelationMergeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRelationMergeRequestValidationError) ErrorName() string {
+	return "GetUserRelationMergeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserRelationMergeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRelationMergeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRelationMergeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRelationMergeRequestValidationError{}
+
+// Validate checks the field values on GetUserRelationMergeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserRelationMergeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRelationMergeReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserRelationMergeReplyMultiError, or nil if none found.
+func (m *GetUserRelationMergeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRelationMergeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMerge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserRelationMergeReplyValidationError{
+					field:  "Merge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserRelationMergeReplyValidationError{
+					field:  "Merge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMerge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserRelationMergeReplyValidationError{
+				field:  "Merge",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserRelationMergeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRelationMergeReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUserRelationMergeReply.ValidateAll() if the
+// designated constraints aren't met.
+type GetUserRelationMergeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRelationMergeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRelationMergeReplyMultiError) AllErrors() []error { return m }
+
+// GetUserRelationMergeReplyValidationError is the validation error returned by
+// GetUserRelationMergeReply.Validate if the designated constraints aren't met.
+type GetUserRelationMergeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRelationMergeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRelationMergeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRelationMergeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRelationMergeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRelationMergeReplyValidationError) ErrorName() string {
+	return "GetUserRelationMergeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserRelationMergeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRelationMergeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRelationMergeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRelationMergeReplyValidationError{}
+
+// Validate checks the field values on RelationHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationHistoryRequestMultiError, or nil if none found.
+func (m *RelationHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := RelationHistoryRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetUid() < 0 {
+		err := RelationHistoryRequestValidationError{
+			field:  "TargetUid",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RelationHistoryRequest_Action_InLookup[m.GetAction()]; !ok {
+		err := RelationHistoryRequestValidationError{
+			field:  "Action",
+			reason: "value must be in list [ follow unfollow]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RelationHistoryRequest_Source_InLookup[m.GetSource()]; !ok {
+		err := RelationHistoryRequestValidationError{
+			field:  "Source",
+			reason: "value must be in list [ app web admin batch]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := RelationHistoryRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := RelationHistoryRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastId() < 0 {
+		err := RelationHistoryRequestValidationError{
+			field:  "LastId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 100 {
+		err := RelationHistoryRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RelationHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationHistoryRequestMultiError) AllErrors() []error { return m }
+
+// RelationHistoryRequestValidationError is the validation error returned by
+// RelationHistoryRequest.Validate if the designated constraints aren't met.
+type RelationHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationHistoryRequestValidationError) ErrorName() string {
+	return "RelationHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationHistoryRequestValidationError{}
+
+var _RelationHistoryRequest_Action_InLookup = map[string]struct{}{
+	"":         {},
+	"follow":   {},
+	"unfollow": {},
+}
+
+var _RelationHistoryRequest_Source_InLookup = map[string]struct{}{
+	"":      {},
+	"app":   {},
+	"web":   {},
+	"admin": {},
+	"batch": {},
+}
+
+// Validate checks the field values on RelationHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationHistoryReplyMultiError, or nil if none found.
+func (m *RelationHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResult() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationHistoryReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationHistoryReplyValidationError{
+						field:  fmt.Sprintf("Result[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationHistoryReplyValidationError{
+					field:  fmt.Sprintf("Result[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by RelationHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type RelationHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationHistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationHistoryReplyMultiError) AllErrors() []error { return m }
+
+// RelationHistoryReplyValidationError is the validation error returned by
+// RelationHistoryReply.Validate if the designated constraints aren't met.
+type RelationHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationHistoryReplyValidationError) ErrorName() string {
+	return "RelationHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationHistoryReplyValidationError{}
+
+// Validate checks the field values on RebuildUserCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebuildUserCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebuildUserCacheRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebuildUserCacheRequestMultiError, or nil if none found.
+func (m *RebuildUserCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebuildUserCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RebuildUserCacheRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RebuildUserCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebuildUserCacheRequestMultiError is an error wrapping multiple validation
+// errors returned by RebuildUserCacheRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RebuildUserCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebuildUserCacheRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebuildUserCacheRequestMultiError) AllErrors() []error { return m }
+
+// RebuildUserCacheRequestValidationError is the validation error returned by
+// RebuildUserCacheRequest.Validate if the designated constraints aren't met.
+type RebuildUserCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildUserCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildUserCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildUserCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildUserCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildUserCacheRequestValidationError) ErrorName() string {
+	return "RebuildUserCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildUserCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebuildUserCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildUserCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildUserCacheRequestValidationError{}
+
+// Validate checks the field values on RebuildUserCacheReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebuildUserCacheReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebuildUserCacheReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebuildUserCacheReplyMultiError, or nil if none found.
+func (m *RebuildUserCacheReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebuildUserCacheReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FollowingCount
+
+	// no validation rules for FollowerCount
+
+	if len(errors) > 0 {
+		return RebuildUserCacheReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebuildUserCacheReplyMultiError is an error wrapping multiple validation
+// errors returned by RebuildUserCacheReply.ValidateAll() if the designated
+// constraints aren't met.
+type RebuildUserCacheReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebuildUserCacheReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebuildUserCacheReplyMultiError) AllErrors() []error { return m }
+
+// RebuildUserCacheReplyValidationError is the validation error returned by
+// RebuildUserCacheReply.Validate if the designated constraints aren't met.
+type RebuildUserCacheReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebuildUserCacheReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebuildUserCacheReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebuildUserCacheReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebuildUserCacheReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebuildUserCacheReplyValidationError) ErrorName() string {
+	return "RebuildUserCacheReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebuildUserCacheReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebuildUserCacheReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebuildUserCacheReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebuildUserCacheReplyValidationError{}
+
+// Validate checks the field values on RelationHistoryReplyRelationLog with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationHistoryReplyRelationLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationHistoryReplyRelationLog with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationHistoryReplyRelationLogMultiError, or nil if none found.
+func (m *RelationHistoryReplyRelationLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationHistoryReplyRelationLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for TargetUid
+
+	// no validation rules for Action
+
+	// no validation rules for Source
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return RelationHistoryReplyRelationLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationHistoryReplyRelationLogMultiError is an error wrapping multiple
+// validation errors returned by RelationHistoryReplyRelationLog.ValidateAll()
+// if the designated constraints aren't met.
+type RelationHistoryReplyRelationLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationHistoryReplyRelationLogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationHistoryReplyRelationLogMultiError) AllErrors() []error { return m }
+
+// RelationHistoryReplyRelationLogValidationError is the validation error
+// returned by RelationHistoryReplyRelationLog.Validate if the designated
+// constraints aren't met.
+type RelationHistoryReplyRelationLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationHistoryReplyRelationLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationHistoryReplyRelationLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationHistoryReplyRelationLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationHistoryReplyRelationLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationHistoryReplyRelationLogValidationError) ErrorName() string {
+	return "RelationHistoryReplyRelationLogValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationHistoryReplyRelationLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationHistoryReplyRelationLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationHistoryReplyRelationLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationHistoryReplyRelationLogValidationError{}
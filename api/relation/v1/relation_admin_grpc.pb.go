@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.18.1
+// source: api/relation/v1/relation_admin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RelationAdminServiceClient is the client API for RelationAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelationAdminServiceClient interface {
+	// 获取两个用户之间关注表和粉丝表的原始记录, 包含两个方向
+	GetRawEdges(ctx context.Context, in *GetRawEdgesRequest, opts ...grpc.CallOption) (*GetRawEdgesReply, error)
+	// 强制关注, 只写关注表和粉丝表, 状态变化时记录来源为 admin 的变更日志, 不更新增长排行
+	ForceFollow(ctx context.Context, in *ForceFollowRequest, opts ...grpc.CallOption) (*ForceFollowReply, error)
+	// 强制取关, 只写关注表和粉丝表, 状态变化时记录来源为 admin 的变更日志, 不更新增长排行
+	ForceUnfollow(ctx context.Context, in *ForceUnfollowRequest, opts ...grpc.CallOption) (*ForceUnfollowReply, error)
+	// 重算用户的关注数和粉丝数
+	RecomputeUserCounters(ctx context.Context, in *RecomputeUserCountersRequest, opts ...grpc.CallOption) (*RecomputeUserCountersReply, error)
+	// 清除用户的关系缓存
+	PurgeUserCache(ctx context.Context, in *PurgeUserCacheRequest, opts ...grpc.CallOption) (*PurgeUserCacheReply, error)
+	// 合并账号, 把 from_uid 的关注和粉丝转移给 to_uid, 异步执行
+	// 自己关注自己和 to_uid 已有的关系会被丢弃, 两个账号之间的关系会被取消
+	// 超过 to_uid 关注数限制和对方用户不可用的关系保留在 from_uid 上, to_uid 不可用时合并失败
+	MergeUserRelations(ctx context.Context, in *MergeUserRelationsRequest, opts ...grpc.CallOption) (*MergeUserRelationsReply, error)
+	// 获取合并的进度和结果
+	GetUserRelationMerge(ctx context.Context, in *GetUserRelationMergeRequest, opts ...grpc.CallOption) (*GetUserRelationMergeReply, error)
+	// 关系变更历史, 供排查问题使用
+	GetRelationHistory(ctx context.Context, in *RelationHistoryRequest, opts ...grpc.CallOption) (*RelationHistoryReply, error)
+	// 从 DB 重建用户的关系缓存, 供定向修复使用
+	RebuildUserCache(ctx context.Context, in *RebuildUserCacheRequest, opts ...grpc.CallOption) (*RebuildUserCacheReply, error)
+}
+
+type relationAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationAdminServiceClient(cc grpc.ClientConnInterface) RelationAdminServiceClient {
+	return &relationAdminServiceClient{cc}
+}
+
+func (c *relationAdminServiceClient) GetRawEdges(ctx context.Context, in *GetRawEdgesRequest, opts ...grpc.CallOption) (*GetRawEdgesReply, error) {
+	out := new(GetRawEdgesReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/GetRawEdges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) ForceFollow(ctx context.Context, in *ForceFollowRequest, opts ...grpc.CallOption) (*ForceFollowReply, error) {
+	out := new(ForceFollowReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/ForceFollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) ForceUnfollow(ctx context.Context, in *ForceUnfollowRequest, opts ...grpc.CallOption) (*ForceUnfollowReply, error) {
+	out := new(ForceUnfollowReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/ForceUnfollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) RecomputeUserCounters(ctx context.Context, in *RecomputeUserCountersRequest, opts ...grpc.CallOption) (*RecomputeUserCountersReply, error) {
+	out := new(RecomputeUserCountersReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/RecomputeUserCounters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) PurgeUserCache(ctx context.Context, in *PurgeUserCacheRequest, opts ...grpc.CallOption) (*PurgeUserCacheReply, error) {
+	out := new(PurgeUserCacheReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/PurgeUserCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) MergeUserRelations(ctx context.Context, in *MergeUserRelationsRequest, opts ...grpc.CallOption) (*MergeUserRelationsReply, error) {
+	out := new(MergeUserRelationsReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/MergeUserRelations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) GetUserRelationMerge(ctx context.Context, in *GetUserRelationMergeRequest, opts ...grpc.CallOption) (*GetUserRelationMergeReply, error) {
+	out := new(GetUserRelationMergeReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/GetUserRelationMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) GetRelationHistory(ctx context.Context, in *RelationHistoryRequest, opts ...grpc.CallOption) (*RelationHistoryReply, error) {
+	out := new(RelationHistoryReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/GetRelationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationAdminServiceClient) RebuildUserCache(ctx context.Context, in *RebuildUserCacheRequest, opts ...grpc.CallOption) (*RebuildUserCacheReply, error) {
+	out := new(RebuildUserCacheReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationAdminService/RebuildUserCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationAdminServiceServer is the server API for RelationAdminService service.
+// All implementations must embed UnimplementedRelationAdminServiceServer
+// for forward compatibility
+type RelationAdminServiceServer interface {
+	// 获取两个用户之间关注表和粉丝表的原始记录, 包含两个方向
+	GetRawEdges(context.Context, *GetRawEdgesRequest) (*GetRawEdgesReply, error)
+	// 强制关注, 只写关注表和粉丝表, 状态变化时记录来源为 admin 的变更日志, 不更新增长排行
+	ForceFollow(context.Context, *ForceFollowRequest) (*ForceFollowReply, error)
+	// 强制取关, 只写关注表和粉丝表, 状态变化时记录来源为 admin 的变更日志, 不更新增长排行
+	ForceUnfollow(context.Context, *ForceUnfollowRequest) (*ForceUnfollowReply, error)
+	// 重算用户的关注数和粉丝数
+	RecomputeUserCounters(context.Context, *RecomputeUserCountersRequest) (*RecomputeUserCountersReply, error)
+	// 清除用户的关系缓存
+	PurgeUserCache(context.Context, *PurgeUserCacheRequest) (*PurgeUserCacheReply, error)
+	// 合并账号, 把 from_uid 的关注和粉丝转移给 to_uid, 异步执行
+	// 自己关注自己和 to_uid 已有的关系会被丢弃, 两个账号之间的关系会被取消
+	// 超过 to_uid 关注数限制和对方用户不可用的关系保留在 from_uid 上, to_uid 不可用时合并失败
+	MergeUserRelations(context.Context, *MergeUserRelationsRequest) (*MergeUserRelationsReply, error)
+	// 获取合并的进度和结果
+	GetUserRelationMerge(context.Context, *GetUserRelationMergeRequest) (*GetUserRelationMergeReply, error)
+	// 关系变更历史, 供排查问题使用
+	GetRelationHistory(context.Context, *RelationHistoryRequest) (*RelationHistoryReply, error)
+	// 从 DB 重建用户的关系缓存, 供定向修复使用
+	RebuildUserCache(context.Context, *RebuildUserCacheRequest) (*RebuildUserCacheReply, error)
+	mustEmbedUnimplementedRelationAdminServiceServer()
+}
+
+// UnimplementedRelationAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRelationAdminServiceServer struct {
+}
+
+func (UnimplementedRelationAdminServiceServer) GetRawEdges(context.Context, *GetRawEdgesRequest) (*GetRawEdgesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawEdges not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) ForceFollow(context.Context, *ForceFollowRequest) (*ForceFollowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceFollow not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) ForceUnfollow(context.Context, *ForceUnfollowRequest) (*ForceUnfollowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUnfollow not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) RecomputeUserCounters(context.Context, *RecomputeUserCountersRequest) (*RecomputeUserCountersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeUserCounters not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) PurgeUserCache(context.Context, *PurgeUserCacheRequest) (*PurgeUserCacheReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserCache not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) MergeUserRelations(context.Context, *MergeUserRelationsRequest) (*MergeUserRelationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeUserRelations not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) GetUserRelationMerge(context.Context, *GetUserRelationMergeRequest) (*GetUserRelationMergeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRelationMerge not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) GetRelationHistory(context.Context, *RelationHistoryRequest) (*RelationHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationHistory not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) RebuildUserCache(context.Context, *RebuildUserCacheRequest) (*RebuildUserCacheReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildUserCache not implemented")
+}
+func (UnimplementedRelationAdminServiceServer) mustEmbedUnimplementedRelationAdminServiceServer() {}
+
+// UnsafeRelationAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationAdminServiceServer will
+// result in compilation errors.
+type UnsafeRelationAdminServiceServer interface {
+	mustEmbedUnimplementedRelationAdminServiceServer()
+}
+
+func RegisterRelationAdminServiceServer(s grpc.ServiceRegistrar, srv RelationAdminServiceServer) {
+	s.RegisterService(&RelationAdminService_ServiceDesc, srv)
+}
+
+func _RelationAdminService_GetRawEdges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawEdgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).GetRawEdges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/GetRawEdges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).GetRawEdges(ctx, req.(*GetRawEdgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_ForceFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).ForceFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/ForceFollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).ForceFollow(ctx, req.(*ForceFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_ForceUnfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceUnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).ForceUnfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/ForceUnfollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).ForceUnfollow(ctx, req.(*ForceUnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_RecomputeUserCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeUserCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).RecomputeUserCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/RecomputeUserCounters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).RecomputeUserCounters(ctx, req.(*RecomputeUserCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_PurgeUserCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).PurgeUserCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/PurgeUserCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).PurgeUserCache(ctx, req.(*PurgeUserCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_MergeUserRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeUserRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).MergeUserRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/MergeUserRelations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).MergeUserRelations(ctx, req.(*MergeUserRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_GetUserRelationMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRelationMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).GetUserRelationMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/GetUserRelationMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).GetUserRelationMerge(ctx, req.(*GetUserRelationMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_GetRelationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).GetRelationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/GetRelationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).GetRelationHistory(ctx, req.(*RelationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationAdminService_RebuildUserCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildUserCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationAdminServiceServer).RebuildUserCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationAdminService/RebuildUserCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationAdminServiceServer).RebuildUserCache(ctx, req.(*RebuildUserCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationAdminService_ServiceDesc is the grpc.ServiceDesc for RelationAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relation.v1.RelationAdminService",
+	HandlerType: (*RelationAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRawEdges",
+			Handler:    _RelationAdminService_GetRawEdges_Handler,
+		},
+		{
+			MethodName: "ForceFollow",
+			Handler:    _RelationAdminService_ForceFollow_Handler,
+		},
+		{
+			MethodName: "ForceUnfollow",
+			Handler:    _RelationAdminService_ForceUnfollow_Handler,
+		},
+		{
+			MethodName: "RecomputeUserCounters",
+			Handler:    _RelationAdminService_RecomputeUserCounters_Handler,
+		},
+		{
+			MethodName: "PurgeUserCache",
+			Handler:    _RelationAdminService_PurgeUserCache_Handler,
+		},
+		{
+			MethodName: "MergeUserRelations",
+			Handler:    _RelationAdminService_MergeUserRelations_Handler,
+		},
+		{
+			MethodName: "GetUserRelationMerge",
+			Handler:    _RelationAdminService_GetUserRelationMerge_Handler,
+		},
+		{
+			MethodName: "GetRelationHistory",
+			Handler:    _RelationAdminService_GetRelationHistory_Handler,
+		},
+		{
+			MethodName: "RebuildUserCache",
+			Handler:    _RelationAdminService_RebuildUserCache_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/relation/v1/relation_admin.proto",
+}
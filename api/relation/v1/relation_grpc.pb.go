@@ -22,9 +22,10 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RelationServiceClient interface {
-	// 关注
+	// 关注, 可能返回 20102, 20103, 20104, 20105, 20108
+	// 关注数上限由用户服务返回的用户等级决定, eg: regular, verified, business
 	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowReply, error)
-	// 取消关注
+	// 取消关注, 可能返回 20103
 	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowReply, error)
 	// 批量获取关注关系, eg: A 对 B,C,D是否已关注
 	BatchGetRelation(ctx context.Context, in *BatchGetRelationRequest, opts ...grpc.CallOption) (*BatchGetRelationReply, error)
@@ -32,6 +33,42 @@ type RelationServiceClient interface {
 	GetFollowingList(ctx context.Context, in *FollowingListRequest, opts ...grpc.CallOption) (*FollowingListReply, error)
 	// 粉丝列表
 	GetFollowerList(ctx context.Context, in *FollowerListRequest, opts ...grpc.CallOption) (*FollowerListReply, error)
+	// 共同关注, eg: viewer 关注的人中有哪些也关注了 target
+	GetCommonFollowers(ctx context.Context, in *CommonFollowersRequest, opts ...grpc.CallOption) (*CommonFollowersReply, error)
+	// 共同关注数
+	CountCommonFollowers(ctx context.Context, in *CountCommonFollowersRequest, opts ...grpc.CallOption) (*CountCommonFollowersReply, error)
+	// 可能认识的人, 按共同关注数排序的二度关系
+	SuggestFollows(ctx context.Context, in *SuggestFollowsRequest, opts ...grpc.CallOption) (*SuggestFollowsReply, error)
+	// 创建分组
+	CreateRelationGroup(ctx context.Context, in *CreateRelationGroupRequest, opts ...grpc.CallOption) (*CreateRelationGroupReply, error)
+	// 修改分组
+	UpdateRelationGroup(ctx context.Context, in *UpdateRelationGroupRequest, opts ...grpc.CallOption) (*UpdateRelationGroupReply, error)
+	// 删除分组, 同时移除组内成员
+	DeleteRelationGroup(ctx context.Context, in *DeleteRelationGroupRequest, opts ...grpc.CallOption) (*DeleteRelationGroupReply, error)
+	// 分组列表
+	ListRelationGroups(ctx context.Context, in *ListRelationGroupsRequest, opts ...grpc.CallOption) (*ListRelationGroupsReply, error)
+	// 添加分组成员, 只能添加已关注的用户
+	AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersReply, error)
+	// 移除分组成员
+	RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersReply, error)
+	// 分组成员列表
+	GetGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersReply, error)
+	// 修改关注关系的属性, eg: 备注名、特别关注、屏蔽动态, 未关注时返回 20107
+	UpdateFollowAttributes(ctx context.Context, in *UpdateFollowAttributesRequest, opts ...grpc.CallOption) (*UpdateFollowAttributesReply, error)
+	// 添加密友, 对方必须已关注自己, 否则返回 20109
+	AddCloseFriend(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption) (*AddCloseFriendReply, error)
+	// 移除密友
+	RemoveCloseFriend(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption) (*RemoveCloseFriendReply, error)
+	// 批量判断是否为密友, eg: B,C,D 是否在 A 的密友列表中
+	BatchIsCloseFriend(ctx context.Context, in *BatchIsCloseFriendRequest, opts ...grpc.CallOption) (*BatchIsCloseFriendReply, error)
+	// 密友列表
+	ListCloseFriends(ctx context.Context, in *ListCloseFriendsRequest, opts ...grpc.CallOption) (*ListCloseFriendsReply, error)
+	// 按来源统计的每日关注/取关数
+	GetFollowSourceStats(ctx context.Context, in *FollowSourceStatsRequest, opts ...grpc.CallOption) (*FollowSourceStatsReply, error)
+	// 粉丝增长最快的用户
+	GetTopGrowingAccounts(ctx context.Context, in *TopGrowingAccountsRequest, opts ...grpc.CallOption) (*TopGrowingAccountsReply, error)
+	// 用户粉丝数的时间序列, 用于粉丝趋势图
+	GetFollowerTimeSeries(ctx context.Context, in *FollowerTimeSeriesRequest, opts ...grpc.CallOption) (*FollowerTimeSeriesReply, error)
 }
 
 type relationServiceClient struct {
@@ -87,13 +124,176 @@ func (c *relationServiceClient) GetFollowerList(ctx context.Context, in *Followe
 	return out, nil
 }
 
+func (c *relationServiceClient) GetCommonFollowers(ctx context.Context, in *CommonFollowersRequest, opts ...grpc.CallOption) (*CommonFollowersReply, error) {
+	out := new(CommonFollowersReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/GetCommonFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) CountCommonFollowers(ctx context.Context, in *CountCommonFollowersRequest, opts ...grpc.CallOption) (*CountCommonFollowersReply, error) {
+	out := new(CountCommonFollowersReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/CountCommonFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) SuggestFollows(ctx context.Context, in *SuggestFollowsRequest, opts ...grpc.CallOption) (*SuggestFollowsReply, error) {
+	out := new(SuggestFollowsReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/SuggestFollows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) CreateRelationGroup(ctx context.Context, in *CreateRelationGroupRequest, opts ...grpc.CallOption) (*CreateRelationGroupReply, error) {
+	out := new(CreateRelationGroupReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/CreateRelationGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) UpdateRelationGroup(ctx context.Context, in *UpdateRelationGroupRequest, opts ...grpc.CallOption) (*UpdateRelationGroupReply, error) {
+	out := new(UpdateRelationGroupReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/UpdateRelationGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) DeleteRelationGroup(ctx context.Context, in *DeleteRelationGroupRequest, opts ...grpc.CallOption) (*DeleteRelationGroupReply, error) {
+	out := new(DeleteRelationGroupReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/DeleteRelationGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) ListRelationGroups(ctx context.Context, in *ListRelationGroupsRequest, opts ...grpc.CallOption) (*ListRelationGroupsReply, error) {
+	out := new(ListRelationGroupsReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/ListRelationGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) AddGroupMembers(ctx context.Context, in *AddGroupMembersRequest, opts ...grpc.CallOption) (*AddGroupMembersReply, error) {
+	out := new(AddGroupMembersReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/AddGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) RemoveGroupMembers(ctx context.Context, in *RemoveGroupMembersRequest, opts ...grpc.CallOption) (*RemoveGroupMembersReply, error) {
+	out := new(RemoveGroupMembersReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/RemoveGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetGroupMembers(ctx context.Context, in *GroupMembersRequest, opts ...grpc.CallOption) (*GroupMembersReply, error) {
+	out := new(GroupMembersReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/GetGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) UpdateFollowAttributes(ctx context.Context, in *UpdateFollowAttributesRequest, opts ...grpc.CallOption) (*UpdateFollowAttributesReply, error) {
+	out := new(UpdateFollowAttributesReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/UpdateFollowAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) AddCloseFriend(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption) (*AddCloseFriendReply, error) {
+	out := new(AddCloseFriendReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/AddCloseFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) RemoveCloseFriend(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption) (*RemoveCloseFriendReply, error) {
+	out := new(RemoveCloseFriendReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/RemoveCloseFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) BatchIsCloseFriend(ctx context.Context, in *BatchIsCloseFriendRequest, opts ...grpc.CallOption) (*BatchIsCloseFriendReply, error) {
+	out := new(BatchIsCloseFriendReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/BatchIsCloseFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) ListCloseFriends(ctx context.Context, in *ListCloseFriendsRequest, opts ...grpc.CallOption) (*ListCloseFriendsReply, error) {
+	out := new(ListCloseFriendsReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/ListCloseFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetFollowSourceStats(ctx context.Context, in *FollowSourceStatsRequest, opts ...grpc.CallOption) (*FollowSourceStatsReply, error) {
+	out := new(FollowSourceStatsReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/GetFollowSourceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetTopGrowingAccounts(ctx context.Context, in *TopGrowingAccountsRequest, opts ...grpc.CallOption) (*TopGrowingAccountsReply, error) {
+	out := new(TopGrowingAccountsReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/GetTopGrowingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationServiceClient) GetFollowerTimeSeries(ctx context.Context, in *FollowerTimeSeriesRequest, opts ...grpc.CallOption) (*FollowerTimeSeriesReply, error) {
+	out := new(FollowerTimeSeriesReply)
+	err := c.cc.Invoke(ctx, "/relation.v1.RelationService/GetFollowerTimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelationServiceServer is the server API for RelationService service.
 // All implementations must embed UnimplementedRelationServiceServer
 // for forward compatibility
 type RelationServiceServer interface {
-	// 关注
+	// 关注, 可能返回 20102, 20103, 20104, 20105, 20108
+	// 关注数上限由用户服务返回的用户等级决定, eg: regular, verified, business
 	Follow(context.Context, *FollowRequest) (*FollowReply, error)
-	// 取消关注
+	// 取消关注, 可能返回 20103
 	Unfollow(context.Context, *UnfollowRequest) (*UnfollowReply, error)
 	// 批量获取关注关系, eg: A 对 B,C,D是否已关注
 	BatchGetRelation(context.Context, *BatchGetRelationRequest) (*BatchGetRelationReply, error)
@@ -101,6 +301,42 @@ type RelationServiceServer interface {
 	GetFollowingList(context.Context, *FollowingListRequest) (*FollowingListReply, error)
 	// 粉丝列表
 	GetFollowerList(context.Context, *FollowerListRequest) (*FollowerListReply, error)
+	// 共同关注, eg: viewer 关注的人中有哪些也关注了 target
+	GetCommonFollowers(context.Context, *CommonFollowersRequest) (*CommonFollowersReply, error)
+	// 共同关注数
+	CountCommonFollowers(context.Context, *CountCommonFollowersRequest) (*CountCommonFollowersReply, error)
+	// 可能认识的人, 按共同关注数排序的二度关系
+	SuggestFollows(context.Context, *SuggestFollowsRequest) (*SuggestFollowsReply, error)
+	// 创建分组
+	CreateRelationGroup(context.Context, *CreateRelationGroupRequest) (*CreateRelationGroupReply, error)
+	// 修改分组
+	UpdateRelationGroup(context.Context, *UpdateRelationGroupRequest) (*UpdateRelationGroupReply, error)
+	// 删除分组, 同时移除组内成员
+	DeleteRelationGroup(context.Context, *DeleteRelationGroupRequest) (*DeleteRelationGroupReply, error)
+	// 分组列表
+	ListRelationGroups(context.Context, *ListRelationGroupsRequest) (*ListRelationGroupsReply, error)
+	// 添加分组成员, 只能添加已关注的用户
+	AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersReply, error)
+	// 移除分组成员
+	RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersReply, error)
+	// 分组成员列表
+	GetGroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersReply, error)
+	// 修改关注关系的属性, eg: 备注名、特别关注、屏蔽动态, 未关注时返回 20107
+	UpdateFollowAttributes(context.Context, *UpdateFollowAttributesRequest) (*UpdateFollowAttributesReply, error)
+	// 添加密友, 对方必须已关注自己, 否则返回 20109
+	AddCloseFriend(context.Context, *AddCloseFriendRequest) (*AddCloseFriendReply, error)
+	// 移除密友
+	RemoveCloseFriend(context.Context, *RemoveCloseFriendRequest) (*RemoveCloseFriendReply, error)
+	// 批量判断是否为密友, eg: B,C,D 是否在 A 的密友列表中
+	BatchIsCloseFriend(context.Context, *BatchIsCloseFriendRequest) (*BatchIsCloseFriendReply, error)
+	// 密友列表
+	ListCloseFriends(context.Context, *ListCloseFriendsRequest) (*ListCloseFriendsReply, error)
+	// 按来源统计的每日关注/取关数
+	GetFollowSourceStats(context.Context, *FollowSourceStatsRequest) (*FollowSourceStatsReply, error)
+	// 粉丝增长最快的用户
+	GetTopGrowingAccounts(context.Context, *TopGrowingAccountsRequest) (*TopGrowingAccountsReply, error)
+	// 用户粉丝数的时间序列, 用于粉丝趋势图
+	GetFollowerTimeSeries(context.Context, *FollowerTimeSeriesRequest) (*FollowerTimeSeriesReply, error)
 	mustEmbedUnimplementedRelationServiceServer()
 }
 
@@ -123,6 +359,60 @@ func (UnimplementedRelationServiceServer) GetFollowingList(context.Context, *Fol
 func (UnimplementedRelationServiceServer) GetFollowerList(context.Context, *FollowerListRequest) (*FollowerListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerList not implemented")
 }
+func (UnimplementedRelationServiceServer) GetCommonFollowers(context.Context, *CommonFollowersRequest) (*CommonFollowersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonFollowers not implemented")
+}
+func (UnimplementedRelationServiceServer) CountCommonFollowers(context.Context, *CountCommonFollowersRequest) (*CountCommonFollowersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountCommonFollowers not implemented")
+}
+func (UnimplementedRelationServiceServer) SuggestFollows(context.Context, *SuggestFollowsRequest) (*SuggestFollowsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFollows not implemented")
+}
+func (UnimplementedRelationServiceServer) CreateRelationGroup(context.Context, *CreateRelationGroupRequest) (*CreateRelationGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelationGroup not implemented")
+}
+func (UnimplementedRelationServiceServer) UpdateRelationGroup(context.Context, *UpdateRelationGroupRequest) (*UpdateRelationGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRelationGroup not implemented")
+}
+func (UnimplementedRelationServiceServer) DeleteRelationGroup(context.Context, *DeleteRelationGroupRequest) (*DeleteRelationGroupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationGroup not implemented")
+}
+func (UnimplementedRelationServiceServer) ListRelationGroups(context.Context, *ListRelationGroupsRequest) (*ListRelationGroupsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelationGroups not implemented")
+}
+func (UnimplementedRelationServiceServer) AddGroupMembers(context.Context, *AddGroupMembersRequest) (*AddGroupMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupMembers not implemented")
+}
+func (UnimplementedRelationServiceServer) RemoveGroupMembers(context.Context, *RemoveGroupMembersRequest) (*RemoveGroupMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMembers not implemented")
+}
+func (UnimplementedRelationServiceServer) GetGroupMembers(context.Context, *GroupMembersRequest) (*GroupMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupMembers not implemented")
+}
+func (UnimplementedRelationServiceServer) UpdateFollowAttributes(context.Context, *UpdateFollowAttributesRequest) (*UpdateFollowAttributesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFollowAttributes not implemented")
+}
+func (UnimplementedRelationServiceServer) AddCloseFriend(context.Context, *AddCloseFriendRequest) (*AddCloseFriendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCloseFriend not implemented")
+}
+func (UnimplementedRelationServiceServer) RemoveCloseFriend(context.Context, *RemoveCloseFriendRequest) (*RemoveCloseFriendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloseFriend not implemented")
+}
+func (UnimplementedRelationServiceServer) BatchIsCloseFriend(context.Context, *BatchIsCloseFriendRequest) (*BatchIsCloseFriendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchIsCloseFriend not implemented")
+}
+func (UnimplementedRelationServiceServer) ListCloseFriends(context.Context, *ListCloseFriendsRequest) (*ListCloseFriendsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCloseFriends not implemented")
+}
+func (UnimplementedRelationServiceServer) GetFollowSourceStats(context.Context, *FollowSourceStatsRequest) (*FollowSourceStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowSourceStats not implemented")
+}
+func (UnimplementedRelationServiceServer) GetTopGrowingAccounts(context.Context, *TopGrowingAccountsRequest) (*TopGrowingAccountsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopGrowingAccounts not implemented")
+}
+func (UnimplementedRelationServiceServer) GetFollowerTimeSeries(context.Context, *FollowerTimeSeriesRequest) (*FollowerTimeSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerTimeSeries not implemented")
+}
 func (UnimplementedRelationServiceServer) mustEmbedUnimplementedRelationServiceServer() {}
 
 // UnsafeRelationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +516,330 @@ func _RelationService_GetFollowerList_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelationService_GetCommonFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommonFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetCommonFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/GetCommonFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetCommonFollowers(ctx, req.(*CommonFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_CountCommonFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountCommonFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).CountCommonFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/CountCommonFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).CountCommonFollowers(ctx, req.(*CountCommonFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_SuggestFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).SuggestFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/SuggestFollows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).SuggestFollows(ctx, req.(*SuggestFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_CreateRelationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRelationGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).CreateRelationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/CreateRelationGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).CreateRelationGroup(ctx, req.(*CreateRelationGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_UpdateRelationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRelationGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).UpdateRelationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/UpdateRelationGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).UpdateRelationGroup(ctx, req.(*UpdateRelationGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_DeleteRelationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRelationGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).DeleteRelationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/DeleteRelationGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).DeleteRelationGroup(ctx, req.(*DeleteRelationGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_ListRelationGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelationGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).ListRelationGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/ListRelationGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).ListRelationGroups(ctx, req.(*ListRelationGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_AddGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).AddGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/AddGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).AddGroupMembers(ctx, req.(*AddGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_RemoveGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RemoveGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/RemoveGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RemoveGroupMembers(ctx, req.(*RemoveGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/GetGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetGroupMembers(ctx, req.(*GroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_UpdateFollowAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFollowAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).UpdateFollowAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/UpdateFollowAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).UpdateFollowAttributes(ctx, req.(*UpdateFollowAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_AddCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).AddCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/AddCloseFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).AddCloseFriend(ctx, req.(*AddCloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_RemoveCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).RemoveCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/RemoveCloseFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).RemoveCloseFriend(ctx, req.(*RemoveCloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_BatchIsCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchIsCloseFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).BatchIsCloseFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/BatchIsCloseFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).BatchIsCloseFriend(ctx, req.(*BatchIsCloseFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_ListCloseFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCloseFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).ListCloseFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/ListCloseFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).ListCloseFriends(ctx, req.(*ListCloseFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetFollowSourceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowSourceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetFollowSourceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/GetFollowSourceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetFollowSourceStats(ctx, req.(*FollowSourceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetTopGrowingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopGrowingAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetTopGrowingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/GetTopGrowingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetTopGrowingAccounts(ctx, req.(*TopGrowingAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationService_GetFollowerTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowerTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationServiceServer).GetFollowerTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relation.v1.RelationService/GetFollowerTimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationServiceServer).GetFollowerTimeSeries(ctx, req.(*FollowerTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RelationService_ServiceDesc is the grpc.ServiceDesc for RelationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +867,78 @@ var RelationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowerList",
 			Handler:    _RelationService_GetFollowerList_Handler,
 		},
+		{
+			MethodName: "GetCommonFollowers",
+			Handler:    _RelationService_GetCommonFollowers_Handler,
+		},
+		{
+			MethodName: "CountCommonFollowers",
+			Handler:    _RelationService_CountCommonFollowers_Handler,
+		},
+		{
+			MethodName: "SuggestFollows",
+			Handler:    _RelationService_SuggestFollows_Handler,
+		},
+		{
+			MethodName: "CreateRelationGroup",
+			Handler:    _RelationService_CreateRelationGroup_Handler,
+		},
+		{
+			MethodName: "UpdateRelationGroup",
+			Handler:    _RelationService_UpdateRelationGroup_Handler,
+		},
+		{
+			MethodName: "DeleteRelationGroup",
+			Handler:    _RelationService_DeleteRelationGroup_Handler,
+		},
+		{
+			MethodName: "ListRelationGroups",
+			Handler:    _RelationService_ListRelationGroups_Handler,
+		},
+		{
+			MethodName: "AddGroupMembers",
+			Handler:    _RelationService_AddGroupMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMembers",
+			Handler:    _RelationService_RemoveGroupMembers_Handler,
+		},
+		{
+			MethodName: "GetGroupMembers",
+			Handler:    _RelationService_GetGroupMembers_Handler,
+		},
+		{
+			MethodName: "UpdateFollowAttributes",
+			Handler:    _RelationService_UpdateFollowAttributes_Handler,
+		},
+		{
+			MethodName: "AddCloseFriend",
+			Handler:    _RelationService_AddCloseFriend_Handler,
+		},
+		{
+			MethodName: "RemoveCloseFriend",
+			Handler:    _RelationService_RemoveCloseFriend_Handler,
+		},
+		{
+			MethodName: "BatchIsCloseFriend",
+			Handler:    _RelationService_BatchIsCloseFriend_Handler,
+		},
+		{
+			MethodName: "ListCloseFriends",
+			Handler:    _RelationService_ListCloseFriends_Handler,
+		},
+		{
+			MethodName: "GetFollowSourceStats",
+			Handler:    _RelationService_GetFollowSourceStats_Handler,
+		},
+		{
+			MethodName: "GetTopGrowingAccounts",
+			Handler:    _RelationService_GetTopGrowingAccounts_Handler,
+		},
+		{
+			MethodName: "GetFollowerTimeSeries",
+			Handler:    _RelationService_GetFollowerTimeSeries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/relation/v1/relation.proto",
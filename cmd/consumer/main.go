@@ -1,5 +1,226 @@
 package main
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	eagle "github.com/go-eagle/eagle/pkg/app"
+	"github.com/go-eagle/eagle/pkg/config"
+	logger "github.com/go-eagle/eagle/pkg/log"
+	"github.com/go-eagle/eagle/pkg/redis"
+	v "github.com/go-eagle/eagle/pkg/version"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/spf13/pflag"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/cdc"
+	"github.com/go-microservice/relation-service/internal/event"
+	"github.com/go-microservice/relation-service/internal/lifecycle"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/quota"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+var (
+	cfgDir  = pflag.StringP("config dir", "c", "config", "config path.")
+	env     = pflag.StringP("env name", "e", "", "env var name.")
+	version = pflag.BoolP("version", "v", false, "show version info.")
+)
+
+// Config consumer config
+type Config struct {
+	// CDC binlog 变更事件, 用于删除缓存
+	CDC cdc.Config
+	// Lifecycle 用户服务的账号事件, 用于删除或转移注销、封禁、合并的用户的关系
+	Lifecycle lifecycle.Config
+}
+
 func main() {
+	pflag.Parse()
+	if *version {
+		ver := v.Get()
+		marshaled, err := json.MarshalIndent(&ver, "", "  ")
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println(string(marshaled))
+		return
+	}
+
+	// init config
+	c := config.New(*cfgDir, config.WithEnv(*env))
+	var appCfg eagle.Config
+	if err := c.Load("app", &appCfg); err != nil {
+		panic(err)
+	}
+	// set global
+	eagle.Conf = &appCfg
+
+	var cfg Config
+	if err := c.Load("consumer", &cfg); err != nil {
+		panic(err)
+	}
+
+	// -------------- init resource -------------
+	logger.Init()
+	rdb, cleanup, err := redis.Init()
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	eventCfg, err := event.LoadConf()
+	if err != nil {
+		panic(err)
+	}
+	if !cfg.CDC.Enable && !eventCfg.Enable && !cfg.Lifecycle.Enable {
+		log.Println("all the consumers are disabled")
+		return
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// the same cache config as the server, so that the keys are the same
+	cacheCfg, err := cache.LoadConf()
+	if err != nil {
+		panic(err)
+	}
+
+	// the consumers run until a signal is received, the others are stopped if one exits
+	var wg sync.WaitGroup
+	run := func(name string, fn func(ctx context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer cancel()
+			log.Printf("%s consumer is running", name)
+			if err := fn(ctx); err != nil {
+				log.Printf("run %s consumer err: %v", name, err)
+				return
+			}
+			log.Printf("%s consumer exited", name)
+		}()
+	}
+
+	if cfg.CDC.Enable {
+		invalidation := cdc.NewCacheInvalidation(
+			cache.NewUserFollowingCache(rdb, cacheCfg),
+			cache.NewUserFollowerCache(rdb, cacheCfg),
+			cache.NewRelationSetCache(rdb, cacheCfg),
+			cache.NewUserCloseFriendCache(rdb, cacheCfg),
+		)
+		source := cdc.NewRedisStreamSource(rdb, cfg.CDC)
+		run("cdc", func(ctx context.Context) error {
+			return source.Run(ctx, invalidation.Handle)
+		})
+	}
+
+	if eventCfg.Enable || cfg.Lifecycle.Enable {
+		db, closeDB, err := model.Init()
+		if err != nil {
+			panic(err)
+		}
+		defer closeDB()
+		repos := newRepos(db, rdb, cacheCfg)
+		// the delayed cache deletions of the handled events are run before exit
+		defer repos.waitInvalidation()
+
+		if eventCfg.Enable {
+			broker, err := event.NewAsynqBroker(eventCfg)
+			if err != nil {
+				panic(err)
+			}
+			defer broker.Close()
+
+			registry := newEventRegistry(repos, rdb, eventCfg)
+			run("event", func(ctx context.Context) error {
+				return broker.Consume(ctx, registry.Dispatch)
+			})
+		}
+
+		if cfg.Lifecycle.Enable {
+			quotaCfg, err := quota.LoadConf()
+			if err != nil {
+				panic(err)
+			}
+			// the other users of the transferred edges must exist, and the tier of the user merged into
+			// limits its followings
+			userChecker, closeUserChecker, err := usercheck.NewUserChecker(usercheck.LoadConf())
+			if err != nil {
+				panic(err)
+			}
+			defer closeUserChecker()
+			quotaChecker := quota.NewChecker(quotaCfg, quota.NewUserTierProvider(userChecker), repos.following)
+			// the events of the removed and transferred edges, the events are dropped if they are disabled
+			publisher, closePublisher, err := event.NewPublisher(eventCfg)
+			if err != nil {
+				panic(err)
+			}
+			defer closePublisher()
+			graph := lifecycle.NewGraph(db, repos.following, repos.follower, repos.closeFriend, repos.groupMember,
+				repos.relationLog, repos.invalidator, publisher, quotaChecker, userChecker, cfg.Lifecycle.EdgeBatchSize)
+			handler := lifecycle.NewEventHandler(graph)
+			subscriber := lifecycle.NewRedisStreamSubscriber(rdb, cfg.Lifecycle)
+			run("lifecycle", func(ctx context.Context) error {
+				return subscriber.Subscribe(ctx, handler.Handle)
+			})
+		}
+	}
+
+	wg.Wait()
+}
+
+type repos struct {
+	following   repository.UserFollowingRepo
+	follower    repository.UserFollowerRepo
+	closeFriend repository.UserCloseFriendRepo
+	groupMember repository.RelationGroupMemberRepo
+	relationLog repository.RelationLogRepo
+	snapshot    repository.UserRelationSnapshotRepo
+	invalidator *repository.CacheInvalidator
+	// waitInvalidation wait for the delayed cache deletions
+	waitInvalidation func()
+}
+
+func newRepos(db *gorm.DB, rdb *goredis.Client, cacheCfg *cache.Config) *repos {
+	setCache := cache.NewRelationSetCache(rdb, cacheCfg)
+	breaker := cache.NewBreaker(rdb, cacheCfg)
+	invalidator, waitInvalidation := repository.NewCacheInvalidator(cacheCfg)
+	return &repos{
+		following:        repository.NewUserFollowing(db, cache.NewUserFollowingCache(rdb, cacheCfg), setCache, breaker, cacheCfg),
+		follower:         repository.NewUserFollower(db, cache.NewUserFollowerCache(rdb, cacheCfg), setCache, breaker, cacheCfg),
+		closeFriend:      repository.NewUserCloseFriend(db, cache.NewUserCloseFriendCache(rdb, cacheCfg), cacheCfg),
+		groupMember:      repository.NewRelationGroupMember(db),
+		relationLog:      repository.NewRelationLog(db),
+		snapshot:         repository.NewUserRelationSnapshot(db),
+		invalidator:      invalidator,
+		waitInvalidation: waitInvalidation,
+	}
+}
 
+// newEventRegistry register the side effects of the relation events
+func newEventRegistry(repos *repos, rdb *goredis.Client, cfg *event.Config) *event.Registry {
+	registry := event.NewRegistry(cfg, event.NewRedisDeadLetterQueue(rdb, cfg), event.NewRedisHandledStore(rdb, cfg))
+	registry.Register(event.HandlerCounter, event.NewCounterHandler(repos.snapshot),
+		event.RetryPolicy{MaxAttempts: 3, Backoff: 200 * time.Millisecond, MaxBackoff: 2 * time.Second},
+		event.TypeFollow, event.TypeUnfollow)
+	registry.Register(event.HandlerCache, event.NewCacheRefreshHandler(repos.following, repos.follower, repos.invalidator),
+		event.RetryPolicy{MaxAttempts: 3, Backoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+		event.TypeFollow, event.TypeUnfollow)
+	registry.Register(event.HandlerNotification, event.NewNotificationHandler(event.NewLogNotifier()),
+		event.RetryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 30 * time.Second},
+		event.TypeFollow)
+	return registry
 }
@@ -12,6 +12,7 @@ import (
 	logger "github.com/go-eagle/eagle/pkg/log"
 	"github.com/go-eagle/eagle/pkg/redis"
 	v "github.com/go-eagle/eagle/pkg/version"
+	"github.com/go-microservice/relation-service/internal/exporter"
 	"github.com/go-microservice/relation-service/internal/model"
 	"github.com/go-microservice/relation-service/internal/tasks"
 	"github.com/spf13/pflag"
@@ -23,6 +24,11 @@ var (
 	cfgDir  = pflag.StringP("config dir", "c", "config", "config path.")
 	env     = pflag.StringP("env name", "e", "", "env var name.")
 	version = pflag.BoolP("version", "v", false, "show version info.")
+
+	warmup      = pflag.String("warmup", "", "enqueue a cache warmup task and exit, active or top.")
+	warmupLimit = pflag.Int("warmup-limit", 10000, "the max number of users to warm up.")
+
+	export = pflag.String("export", "", "enqueue a relation export task and exit, full or incremental.")
 )
 
 func init() {
@@ -64,6 +70,24 @@ func main() {
 		panic(err)
 	}
 
+	// enqueue a cache warmup task, eg: ./cron --warmup=top --warmup-limit=1000
+	if *warmup != "" {
+		enqueueCacheWarmup(cfg)
+		return
+	}
+	// eg: ./cron --export=full
+	if *export != "" {
+		enqueueRelationExport(cfg)
+		return
+	}
+
+	// the client enqueues the tasks from the handlers, eg: the suggest follows of the active users
+	client, clientClean, err := tasks.NewClient()
+	if err != nil {
+		panic(err)
+	}
+	defer clientClean()
+
 	// -------------- Run worker server ------------
 	go func() {
 		srv := asynq.NewServer(
@@ -85,6 +109,16 @@ func main() {
 		mux := asynq.NewServeMux()
 		// register handlers...
 		mux.HandleFunc(tasks.TypeEmailWelcome, tasks.HandleEmailWelcomeTask)
+		mux.HandleFunc(tasks.TypeSuggestFollowsDispatch, tasks.NewSuggestFollowsDispatchHandler(client))
+		mux.HandleFunc(tasks.TypeSuggestFollows, tasks.HandleSuggestFollowsTask)
+		mux.HandleFunc(tasks.TypeRelationLogRetention, tasks.HandleRelationLogRetentionTask)
+		mux.HandleFunc(tasks.TypeFollowSourceRollup, tasks.HandleFollowSourceRollupTask)
+		mux.HandleFunc(tasks.TypeGrowthLeaderboardRebuild, tasks.HandleGrowthLeaderboardRebuildTask)
+		mux.HandleFunc(tasks.TypeRelationSnapshot, tasks.HandleRelationSnapshotTask)
+		mux.HandleFunc(tasks.TypeRelationSnapshotRetention, tasks.HandleRelationSnapshotRetentionTask)
+		mux.HandleFunc(tasks.TypeCacheWarmup, tasks.HandleCacheWarmupTask)
+		mux.HandleFunc(tasks.TypeRelationExport, tasks.HandleRelationExportTask)
+		mux.HandleFunc(tasks.TypeUserRelationMerge, tasks.HandleUserRelationMergeTask)
 
 		if err := srv.Run(mux); err != nil {
 			log.Fatalf("could not run server: %v", err)
@@ -102,9 +136,104 @@ func main() {
 	if _, err := scheduler.Register("@every 5s", t); err != nil {
 		log.Fatal(err)
 	}
+	t, _ = tasks.NewSuggestFollowsDispatchTask()
+	if _, err := scheduler.Register("@every 6h", t, asynq.Queue(tasks.QueueLow)); err != nil {
+		log.Fatal(err)
+	}
+	t, _ = tasks.NewRelationLogRetentionTask(cfg.RelationLogRetentionDays)
+	if _, err := scheduler.Register("@daily", t, asynq.Queue(tasks.QueueLow)); err != nil {
+		log.Fatal(err)
+	}
+	// rollup the previous day after midnight
+	t, _ = tasks.NewFollowSourceRollupTask("")
+	if _, err := scheduler.Register("30 0 * * *", t, asynq.Queue(tasks.QueueLow)); err != nil {
+		log.Fatal(err)
+	}
+	// only the missing buckets are rebuilt, so it is cheap when redis is warm
+	t, _ = tasks.NewGrowthLeaderboardRebuildTask(false)
+	if _, err := scheduler.Register("@every 10m", t, asynq.Queue(tasks.QueueLow)); err != nil {
+		log.Fatal(err)
+	}
+	// snapshot the previous day after midnight
+	t, _ = tasks.NewRelationSnapshotTask("")
+	if _, err := scheduler.Register("10 0 * * *", t, asynq.Queue(tasks.QueueLow)); err != nil {
+		log.Fatal(err)
+	}
+	t, _ = tasks.NewRelationSnapshotRetentionTask(cfg.RelationSnapshotRetentionDays)
+	if _, err := scheduler.Register("@daily", t, asynq.Queue(tasks.QueueLow)); err != nil {
+		log.Fatal(err)
+	}
+	// the export runs only when the dir is configured
+	if cfg.Export.Dir != "" {
+		specs := map[string]string{
+			exporter.ModeFull:        cfg.Export.FullSpec,
+			exporter.ModeIncremental: cfg.Export.IncrementalSpec,
+		}
+		for mode, spec := range specs {
+			if spec == "" {
+				continue
+			}
+			t, _ = tasks.NewRelationExportTask(mode, cfg.Export)
+			if _, err := scheduler.Register(spec, t, relationExportOpts(cfg)...); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
 
 	// Run blocks and waits for os signal to terminate the program.
 	if err := scheduler.Run(); err != nil {
 		log.Fatal(err)
 	}
 }
+
+func enqueueCacheWarmup(cfg tasks.Config) {
+	t, err := tasks.NewCacheWarmupTask(tasks.CacheWarmupPayload{
+		Mode:  *warmup,
+		Limit: *warmupLimit,
+		Rate:  cfg.CacheWarmupRate,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	rate := cfg.CacheWarmupRate
+	if rate <= 0 {
+		rate = tasks.DefaultCacheWarmupRate
+	}
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: cfg.Addr})
+	defer client.Close()
+	// the task runs as long as limit/rate seconds
+	timeout := time.Duration(*warmupLimit/rate)*time.Second + 10*time.Minute
+	info, err := client.Enqueue(t, asynq.Queue(tasks.QueueLow), asynq.Timeout(timeout), asynq.MaxRetry(1))
+	if err != nil {
+		log.Fatalf("could not enqueue task: %v", err)
+	}
+	log.Printf("enqueued cache warmup task: id=%s mode=%s limit=%d", info.ID, *warmup, *warmupLimit)
+}
+
+func enqueueRelationExport(cfg tasks.Config) {
+	if cfg.Export.Dir == "" {
+		log.Fatal("Export.Dir is not configured in cron.yaml")
+	}
+	t, err := tasks.NewRelationExportTask(*export, cfg.Export)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := asynq.NewClient(asynq.RedisClientOpt{Addr: cfg.Addr})
+	defer client.Close()
+	info, err := client.Enqueue(t, relationExportOpts(cfg)...)
+	if err != nil {
+		log.Fatalf("could not enqueue task: %v", err)
+	}
+	log.Printf("enqueued relation export task: id=%s mode=%s", info.ID, *export)
+}
+
+// relationExportOpts an export is not retried, the partial files are left without a manifest
+func relationExportOpts(cfg tasks.Config) []asynq.Option {
+	timeout := cfg.Export.Timeout
+	if timeout <= 0 {
+		timeout = tasks.DefaultRelationExportTimeout
+	}
+	return []asynq.Option{asynq.Queue(tasks.QueueLow), asynq.Timeout(timeout), asynq.MaxRetry(0)}
+}
@@ -0,0 +1,715 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/go-microservice/relation-service/api/relation/v1"
+)
+
+const (
+	// stdinArg read the ids from stdin instead of the args
+	stdinArg = "-"
+	// maxBatchIDs the max ids of a batch request, see the repeated rules in api/relation/v1/relation.proto
+	maxBatchIDs = 100
+)
+
+type ctl struct {
+	client v1.RelationServiceClient
+	admin  v1.RelationAdminServiceClient
+	// token the admin token, sent as authorization: Bearer {token}
+	token   string
+	timeout time.Duration
+	stdin   io.Reader
+}
+
+// call run a rpc with the timeout
+func (c *ctl) call(fn func(ctx context.Context) (proto.Message, error)) (proto.Message, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// callAdmin run a rpc of RelationAdminService with the admin token
+func (c *ctl) callAdmin(fn func(ctx context.Context) (proto.Message, error)) (proto.Message, error) {
+	if c.token == "" {
+		return nil, fmt.Errorf("the admin token is required, set --admin-token or $%s", adminTokenEnv)
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return fn(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token))
+	})
+}
+
+type command struct {
+	name string
+	// args the positional args, shown in the help
+	args string
+	desc string
+	// run parse the args and call the rpc, the result is a proto message or a table
+	run func(c *ctl, args []string) (interface{}, error)
+}
+
+var commands []*command
+
+// the commands refer to the registry in their usage, so it is set in init
+func init() {
+	commands = []*command{
+		{name: "follow", args: "<user_id> <followed_uid|->", desc: "follow a user, or the users read from stdin", run: runFollow},
+		{name: "unfollow", args: "<user_id> <followed_uid|->", desc: "unfollow a user, or the users read from stdin", run: runUnfollow},
+		{name: "batch-get-relation", args: "<user_id> <uid...|->", desc: "get the follow status of the users", run: runBatchGetRelation},
+		{name: "following-list", args: "<user_id>", desc: "list the followings of a user", run: runFollowingList},
+		{name: "follower-list", args: "<user_id>", desc: "list the followers of a user", run: runFollowerList},
+		{name: "common-followers", args: "<viewer_id> <target_id>", desc: "list the followings of viewer who follow target", run: runCommonFollowers},
+		{name: "count-common-followers", args: "<viewer_id> <target_id>", desc: "count the common followers", run: runCountCommonFollowers},
+		{name: "suggest-follows", args: "<user_id>", desc: "list the suggested users to follow", run: runSuggestFollows},
+		{name: "create-group", args: "<user_id> <name>", desc: "create a relation group", run: runCreateGroup},
+		{name: "update-group", args: "<user_id> <group_id> <name>", desc: "rename a relation group", run: runUpdateGroup},
+		{name: "delete-group", args: "<user_id> <group_id>", desc: "delete a relation group", run: runDeleteGroup},
+		{name: "list-groups", args: "<user_id>", desc: "list the relation groups of a user", run: runListGroups},
+		{name: "add-group-members", args: "<user_id> <group_id> <uid...|->", desc: "add the followings into a group", run: runAddGroupMembers},
+		{name: "remove-group-members", args: "<user_id> <group_id> <uid...|->", desc: "remove the members from a group", run: runRemoveGroupMembers},
+		{name: "group-members", args: "<user_id> <group_id>", desc: "list the members of a group", run: runGroupMembers},
+		{name: "update-follow-attributes", args: "<user_id> <followed_uid>", desc: "update the remark, special or muted of a following", run: runUpdateFollowAttributes},
+		{name: "add-close-friend", args: "<user_id> <friend_uid>", desc: "add a close friend", run: runAddCloseFriend},
+		{name: "remove-close-friend", args: "<user_id> <friend_uid>", desc: "remove a close friend", run: runRemoveCloseFriend},
+		{name: "batch-is-close-friend", args: "<user_id> <uid...|->", desc: "check whether the users are close friends", run: runBatchIsCloseFriend},
+		{name: "list-close-friends", args: "<user_id>", desc: "list the close friends of a user", run: runListCloseFriends},
+		{name: "relation-history", args: "", desc: "list the follow and unfollow logs, admin", run: runRelationHistory},
+		{name: "follow-source-stats", args: "<start_date> <end_date>", desc: "get the daily follow counts by source", run: runFollowSourceStats},
+		{name: "top-growing-accounts", args: "", desc: "list the accounts with the most follower growth", run: runTopGrowingAccounts},
+		{name: "follower-time-series", args: "<user_id> <from> <to>", desc: "get the follower counts by day, week or month", run: runFollowerTimeSeries},
+		{name: "rebuild-user-cache", args: "<user_id>", desc: "reload the relation caches of a user from DB, admin", run: runRebuildUserCache},
+	}
+}
+
+func findCommand(name string) (*command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return nil, false
+}
+
+// parseArgs parse the flags of a command and check the number of positional args
+func parseArgs(fs *pflag.FlagSet, args []string, min int) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() < min {
+		fs.Usage()
+		return nil, fmt.Errorf("expect at least %d args, got %d", min, fs.NArg())
+	}
+	return fs.Args(), nil
+}
+
+func newFlagSet(name string) *pflag.FlagSet {
+	cmd, _ := findCommand(name)
+	fs := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: relationctl %s %s\n", cmd.name, cmd.args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func parseID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid id: %s", s)
+	}
+	return id, nil
+}
+
+// parseIDs parse the ids in the args, they are read from stdin if the args are "-", the duplicated ids are removed
+func (c *ctl) parseIDs(args []string) ([]int64, error) {
+	if len(args) == 1 && args[0] == stdinArg {
+		return readIDs(c.stdin)
+	}
+	ids := make([]int64, 0, len(args))
+	for _, v := range args {
+		id, err := parseID(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no ids")
+	}
+	return uniqueIDs(ids), nil
+}
+
+// readIDs read the ids separated by spaces, commas or new lines, the lines starting with # are ignored,
+// the duplicated ids are removed
+func readIDs(r io.Reader) ([]int64, error) {
+	ids := make([]int64, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, v := range strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+			id, err := parseID(v)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no ids in stdin")
+	}
+	return uniqueIDs(ids), nil
+}
+
+// uniqueIDs remove the duplicated ids and keep the order
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	ret := ids[:0]
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ret = append(ret, id)
+	}
+	return ret
+}
+
+// callBatches call fn with the ids by the batches of maxBatchIDs, the maps and lists of the replies are merged.
+// It stops at the first failed batch, the batches before it are done already.
+func (c *ctl) callBatches(ids []int64, fn func(ctx context.Context, ids []int64) (proto.Message, error)) (proto.Message, error) {
+	var ret proto.Message
+	for start := 0; start < len(ids); start += maxBatchIDs {
+		end := start + maxBatchIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+		reply, err := c.call(func(ctx context.Context) (proto.Message, error) { return fn(ctx, batch) })
+		if err != nil {
+			if start > 0 {
+				return nil, fmt.Errorf("the ids from %d failed, the ids before it are done: %w", batch[0], err)
+			}
+			return nil, err
+		}
+		if ret == nil {
+			ret = reply
+			continue
+		}
+		proto.Merge(ret, reply)
+	}
+	return ret, nil
+}
+
+// parseIDArgs parse the leading ids of the positional args
+func parseIDArgs(args []string, n int) ([]int64, error) {
+	ids := make([]int64, 0, n)
+	for _, v := range args[:n] {
+		id, err := parseID(v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// batchResult the result of a call in a batch read from stdin
+type batchResult struct {
+	UID   int64  `json:"uid"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+type batchResults []*batchResult
+
+func (r batchResults) header() []string {
+	return []string{"uid", "ok", "error"}
+}
+
+func (r batchResults) rows() [][]string {
+	rows := make([][]string, 0, len(r))
+	for _, v := range r {
+		rows = append(rows, []string{strconv.FormatInt(v.UID, 10), strconv.FormatBool(v.OK), v.Error})
+	}
+	return rows
+}
+
+// each call fn for the target uid, or every uid read from stdin
+func (c *ctl) each(target string, fn func(ctx context.Context, uid int64) (proto.Message, error)) (interface{}, error) {
+	if target != stdinArg {
+		uid, err := parseID(target)
+		if err != nil {
+			return nil, err
+		}
+		return c.call(func(ctx context.Context) (proto.Message, error) { return fn(ctx, uid) })
+	}
+
+	uids, err := readIDs(c.stdin)
+	if err != nil {
+		return nil, err
+	}
+	results := make(batchResults, 0, len(uids))
+	for _, uid := range uids {
+		uid := uid
+		_, err := c.call(func(ctx context.Context) (proto.Message, error) { return fn(ctx, uid) })
+		ret := &batchResult{UID: uid, OK: err == nil}
+		if err != nil {
+			ret.Error = err.Error()
+		}
+		results = append(results, ret)
+	}
+	return results, nil
+}
+
+func runFollow(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("follow")
+	source := fs.String("source", "", "the follow source, profile, suggestion, search, feed or share.")
+	meta := fs.StringToString("meta", nil, "the source meta, eg: --meta query=go,pos=1")
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	req := &v1.FollowRequest{UserId: userID, SourceMeta: *meta}
+	if *source != "" {
+		v, ok := v1.FollowSource_value["FOLLOW_SOURCE_"+strings.ToUpper(*source)]
+		if !ok {
+			return nil, fmt.Errorf("invalid source: %s", *source)
+		}
+		req.Source = v1.FollowSource(v)
+	}
+	return c.each(args[1], func(ctx context.Context, uid int64) (proto.Message, error) {
+		r := proto.Clone(req).(*v1.FollowRequest)
+		r.FollowedUid = uid
+		return c.client.Follow(ctx, r)
+	})
+}
+
+func runUnfollow(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("unfollow"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.each(args[1], func(ctx context.Context, uid int64) (proto.Message, error) {
+		return c.client.Unfollow(ctx, &v1.UnfollowRequest{UserId: userID, FollowedUid: uid})
+	})
+}
+
+func runBatchGetRelation(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("batch-get-relation"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	ids, err := c.parseIDs(args[1:])
+	if err != nil {
+		return nil, err
+	}
+	return c.callBatches(ids, func(ctx context.Context, ids []int64) (proto.Message, error) {
+		return c.client.BatchGetRelation(ctx, &v1.BatchGetRelationRequest{UserId: userID, Ids: ids})
+	})
+}
+
+// pageFlags the flags of the list commands
+func pageFlags(fs *pflag.FlagSet) (*int64, *int32) {
+	return fs.Int64("last-id", 0, "the id of the last row of the previous page."),
+		fs.Int32("limit", 20, "the page size.")
+}
+
+func runFollowingList(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("following-list")
+	lastID, limit := pageFlags(fs)
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetFollowingList(ctx, &v1.FollowingListRequest{UserId: userID, LastId: *lastID, Limit: *limit})
+	})
+}
+
+func runFollowerList(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("follower-list")
+	lastID, limit := pageFlags(fs)
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetFollowerList(ctx, &v1.FollowerListRequest{UserId: userID, LastId: *lastID, Limit: *limit})
+	})
+}
+
+func runCommonFollowers(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("common-followers")
+	limit := fs.Int32("limit", 0, "the max number of users, the server default is used if it is 0.")
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetCommonFollowers(ctx, &v1.CommonFollowersRequest{ViewerId: ids[0], TargetId: ids[1], Limit: *limit})
+	})
+}
+
+func runCountCommonFollowers(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("count-common-followers"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.CountCommonFollowers(ctx, &v1.CountCommonFollowersRequest{ViewerId: ids[0], TargetId: ids[1]})
+	})
+}
+
+func runSuggestFollows(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("suggest-follows")
+	limit := fs.Int32("limit", 0, "the max number of users, the server default is used if it is 0.")
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.SuggestFollows(ctx, &v1.SuggestFollowsRequest{UserId: userID, Limit: *limit})
+	})
+}
+
+func runCreateGroup(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("create-group"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.CreateRelationGroup(ctx, &v1.CreateRelationGroupRequest{UserId: userID, Name: args[1]})
+	})
+}
+
+func runUpdateGroup(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("update-group"), args, 3)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.UpdateRelationGroup(ctx, &v1.UpdateRelationGroupRequest{UserId: ids[0], GroupId: ids[1], Name: args[2]})
+	})
+}
+
+func runDeleteGroup(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("delete-group"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.DeleteRelationGroup(ctx, &v1.DeleteRelationGroupRequest{UserId: ids[0], GroupId: ids[1]})
+	})
+}
+
+func runListGroups(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("list-groups"), args, 1)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.ListRelationGroups(ctx, &v1.ListRelationGroupsRequest{UserId: userID})
+	})
+}
+
+func runAddGroupMembers(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("add-group-members"), args, 3)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	uids, err := c.parseIDs(args[2:])
+	if err != nil {
+		return nil, err
+	}
+	return c.callBatches(uids, func(ctx context.Context, uids []int64) (proto.Message, error) {
+		return c.client.AddGroupMembers(ctx, &v1.AddGroupMembersRequest{UserId: ids[0], GroupId: ids[1], Uids: uids})
+	})
+}
+
+func runRemoveGroupMembers(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("remove-group-members"), args, 3)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	uids, err := c.parseIDs(args[2:])
+	if err != nil {
+		return nil, err
+	}
+	return c.callBatches(uids, func(ctx context.Context, uids []int64) (proto.Message, error) {
+		return c.client.RemoveGroupMembers(ctx, &v1.RemoveGroupMembersRequest{UserId: ids[0], GroupId: ids[1], Uids: uids})
+	})
+}
+
+func runGroupMembers(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("group-members")
+	lastID, limit := pageFlags(fs)
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetGroupMembers(ctx, &v1.GroupMembersRequest{UserId: ids[0], GroupId: ids[1], LastId: *lastID, Limit: *limit})
+	})
+}
+
+func runUpdateFollowAttributes(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("update-follow-attributes")
+	remark := fs.String("remark", "", "the remark name, empty to clear it.")
+	special := fs.Bool("special", false, "mark as a special following.")
+	muted := fs.Bool("muted", false, "mute the feeds of the following.")
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	// only the given flags are updated
+	req := &v1.UpdateFollowAttributesRequest{UserId: ids[0], FollowedUid: ids[1]}
+	if fs.Changed("remark") {
+		req.Remark = remark
+	}
+	if fs.Changed("special") {
+		req.Special = special
+	}
+	if fs.Changed("muted") {
+		req.Muted = muted
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.UpdateFollowAttributes(ctx, req)
+	})
+}
+
+func runAddCloseFriend(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("add-close-friend"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.AddCloseFriend(ctx, &v1.AddCloseFriendRequest{UserId: ids[0], FriendUid: ids[1]})
+	})
+}
+
+func runRemoveCloseFriend(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("remove-close-friend"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseIDArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.RemoveCloseFriend(ctx, &v1.RemoveCloseFriendRequest{UserId: ids[0], FriendUid: ids[1]})
+	})
+}
+
+func runBatchIsCloseFriend(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("batch-is-close-friend"), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	ids, err := c.parseIDs(args[1:])
+	if err != nil {
+		return nil, err
+	}
+	return c.callBatches(ids, func(ctx context.Context, ids []int64) (proto.Message, error) {
+		return c.client.BatchIsCloseFriend(ctx, &v1.BatchIsCloseFriendRequest{UserId: userID, Ids: ids})
+	})
+}
+
+func runListCloseFriends(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("list-close-friends")
+	lastID, limit := pageFlags(fs)
+	args, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.ListCloseFriends(ctx, &v1.ListCloseFriendsRequest{UserId: userID, LastId: *lastID, Limit: *limit})
+	})
+}
+
+func runRelationHistory(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("relation-history")
+	req := &v1.RelationHistoryRequest{}
+	fs.Int64Var(&req.UserId, "user-id", 0, "the operator.")
+	fs.Int64Var(&req.TargetUid, "target-uid", 0, "the operated user.")
+	fs.StringVar(&req.Action, "action", "", "follow or unfollow.")
+	fs.StringVar(&req.Source, "source", "", "app, web, admin or batch.")
+	fs.Int64Var(&req.StartTime, "start-time", 0, "the start time, unix timestamp.")
+	fs.Int64Var(&req.EndTime, "end-time", 0, "the end time, unix timestamp.")
+	fs.Int64Var(&req.LastId, "last-id", 0, "the id of the last row of the previous page.")
+	fs.Int32Var(&req.Limit, "limit", 20, "the page size.")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return nil, err
+	}
+	return c.callAdmin(func(ctx context.Context) (proto.Message, error) {
+		return c.admin.GetRelationHistory(ctx, req)
+	})
+}
+
+func runFollowSourceStats(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("follow-source-stats")
+	source := fs.String("source", "", "only the source, profile, suggestion, search, feed or share.")
+	args, err := parseArgs(fs, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	req := &v1.FollowSourceStatsRequest{StartDate: args[0], EndDate: args[1]}
+	if *source != "" {
+		v, ok := v1.FollowSource_value["FOLLOW_SOURCE_"+strings.ToUpper(*source)]
+		if !ok {
+			return nil, fmt.Errorf("invalid source: %s", *source)
+		}
+		req.Source = v1.FollowSource(v).Enum()
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetFollowSourceStats(ctx, req)
+	})
+}
+
+func runTopGrowingAccounts(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("top-growing-accounts")
+	window := fs.String("window", "day", "the growth window, hour, day or week.")
+	limit := fs.Int32("limit", 0, "the max number of accounts, the server default is used if it is 0.")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return nil, err
+	}
+	v, ok := v1.GrowthWindow_value["GROWTH_WINDOW_"+strings.ToUpper(*window)]
+	if !ok {
+		return nil, fmt.Errorf("invalid window: %s", *window)
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetTopGrowingAccounts(ctx, &v1.TopGrowingAccountsRequest{Window: v1.GrowthWindow(v), Limit: *limit})
+	})
+}
+
+func runFollowerTimeSeries(c *ctl, args []string) (interface{}, error) {
+	fs := newFlagSet("follower-time-series")
+	granularity := fs.String("granularity", "day", "day, week or month.")
+	args, err := parseArgs(fs, args, 3)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	v, ok := v1.TimeSeriesGranularity_value["TIME_SERIES_GRANULARITY_"+strings.ToUpper(*granularity)]
+	if !ok {
+		return nil, fmt.Errorf("invalid granularity: %s", *granularity)
+	}
+	return c.call(func(ctx context.Context) (proto.Message, error) {
+		return c.client.GetFollowerTimeSeries(ctx, &v1.FollowerTimeSeriesRequest{
+			UserId:      userID,
+			From:        args[1],
+			To:          args[2],
+			Granularity: v1.TimeSeriesGranularity(v),
+		})
+	})
+}
+
+func runRebuildUserCache(c *ctl, args []string) (interface{}, error) {
+	args, err := parseArgs(newFlagSet("rebuild-user-cache"), args, 1)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.callAdmin(func(ctx context.Context) (proto.Message, error) {
+		return c.admin.RebuildUserCache(ctx, &v1.RebuildUserCacheRequest{UserId: userID})
+	})
+}
@@ -0,0 +1,121 @@
+// relationctl a command-line client of RelationService, eg:
+//
+//	relationctl -e dev follow 1 2
+//	relationctl -o json following-list 1 --limit 20
+//	cat uids.txt | relationctl batch-get-relation 1 -
+//
+// the commands of RelationAdminService need an admin token, eg:
+//
+//	RELATIONCTL_ADMIN_TOKEN=xxx relationctl relation-history --user-id 1
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	eagle "github.com/go-eagle/eagle/pkg/app"
+	"github.com/go-eagle/eagle/pkg/config"
+	"github.com/go-eagle/eagle/pkg/transport/grpc"
+	"github.com/spf13/pflag"
+
+	v1 "github.com/go-microservice/relation-service/api/relation/v1"
+)
+
+var (
+	cfgDir  = pflag.StringP("config dir", "c", "config", "config path.")
+	env     = pflag.StringP("env name", "e", "", "env var name.")
+	addr    = pflag.String("addr", "", "the address of relation-service, the grpc addr in app.yaml is used if it is empty.")
+	output  = pflag.StringP("output", "o", outputTable, "output format, table or json.")
+	timeout = pflag.Duration("timeout", 5*time.Second, "the timeout of each call.")
+	token   = pflag.String("admin-token", os.Getenv(adminTokenEnv), "the token of the admin commands, default is $"+adminTokenEnv+".")
+)
+
+// adminTokenEnv the env of the admin token, so that it is not left in the shell history
+const adminTokenEnv = "RELATIONCTL_ADMIN_TOKEN"
+
+func main() {
+	// the flags after the subcommand belong to the subcommand
+	pflag.CommandLine.SetInterspersed(false)
+	pflag.Usage = usage
+	pflag.Parse()
+
+	args := pflag.Args()
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n\n", args[0])
+		usage()
+		os.Exit(2)
+	}
+	if *output != outputTable && *output != outputJSON {
+		fmt.Fprintf(os.Stderr, "unsupported output: %s\n", *output)
+		os.Exit(2)
+	}
+
+	target, err := serverAddr()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "load config err: %v\n", err)
+		os.Exit(1)
+	}
+	conn, err := grpc.DialInsecure(context.Background(), grpc.WithEndpoint(target), grpc.WithTimeout(*timeout))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dial %s err: %v\n", target, err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctl := &ctl{
+		client:  v1.NewRelationServiceClient(conn),
+		admin:   v1.NewRelationAdminServiceClient(conn),
+		token:   *token,
+		timeout: *timeout,
+		stdin:   os.Stdin,
+	}
+	ret, err := cmd.run(ctl, args[1:])
+	if errors.Is(err, pflag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", cmd.name, err)
+		os.Exit(1)
+	}
+	if err := printResult(os.Stdout, *output, ret); err != nil {
+		fmt.Fprintf(os.Stderr, "print result err: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// serverAddr the --addr flag, or the grpc addr of app.yaml in the config dir
+func serverAddr() (string, error) {
+	if *addr != "" {
+		return *addr, nil
+	}
+
+	c := config.New(*cfgDir, config.WithEnv(*env))
+	var cfg eagle.Config
+	if err := c.Load("app", &cfg); err != nil {
+		return "", err
+	}
+	// the server listens on all interfaces, eg: :9093
+	if strings.HasPrefix(cfg.GRPC.Addr, ":") {
+		return "localhost" + cfg.GRPC.Addr, nil
+	}
+	return cfg.GRPC.Addr, nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: relationctl [flags] <command> [args]\n\nflags:\n")
+	pflag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, "\ncommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-26s %s\n", cmd.name, cmd.desc)
+	}
+	fmt.Fprintf(os.Stderr, "\nrun 'relationctl <command> --help' for the args of a command.\n")
+}
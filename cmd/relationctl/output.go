@@ -0,0 +1,207 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// table a result which is not a proto message
+type table interface {
+	header() []string
+	rows() [][]string
+}
+
+func printResult(w io.Writer, format string, ret interface{}) error {
+	if format == outputJSON {
+		return printJSON(w, ret)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch v := ret.(type) {
+	case proto.Message:
+		printMessage(tw, v.ProtoReflect())
+	case table:
+		printTable(tw, v.header(), v.rows())
+	default:
+		return fmt.Errorf("unsupported result: %T", ret)
+	}
+	return tw.Flush()
+}
+
+func printJSON(w io.Writer, ret interface{}) error {
+	var (
+		b   []byte
+		err error
+	)
+	if m, ok := ret.(proto.Message); ok {
+		b, err = protojson.MarshalOptions{Multiline: true, UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	} else {
+		b, err = json.MarshalIndent(ret, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+// printMessage print the scalar fields as name: value, and each list or map field as a table
+func printMessage(w io.Writer, m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	if fields.Len() == 0 {
+		fmt.Fprintln(w, "OK")
+		return
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.IsList():
+			printList(w, fd, m.Get(fd).List())
+		case fd.IsMap():
+			printMap(w, fd, m.Get(fd).Map())
+		case fd.Kind() == protoreflect.MessageKind:
+			if !m.Has(fd) {
+				continue
+			}
+			sub := m.Get(fd).Message()
+			header := columns(sub.Descriptor())
+			printTable(w, header, [][]string{flatten(sub)})
+		default:
+			fmt.Fprintf(w, "%s:\t%s\n", fd.Name(), formatValue(fd, m.Get(fd)))
+		}
+	}
+}
+
+func printList(w io.Writer, fd protoreflect.FieldDescriptor, list protoreflect.List) {
+	if fd.Kind() != protoreflect.MessageKind {
+		rows := make([][]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			rows = append(rows, []string{formatScalar(fd, list.Get(i))})
+		}
+		printTable(w, []string{string(fd.Name())}, rows)
+		return
+	}
+
+	rows := make([][]string, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		rows = append(rows, flatten(list.Get(i).Message()))
+	}
+	printTable(w, columns(fd.Message()), rows)
+}
+
+func printMap(w io.Writer, fd protoreflect.FieldDescriptor, m protoreflect.Map) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	// the keys of the relation maps are uids
+	sort.Slice(keys, func(i, j int) bool {
+		if fd.MapKey().Kind() == protoreflect.Int64Kind {
+			return keys[i].Int() < keys[j].Int()
+		}
+		return keys[i].String() < keys[j].String()
+	})
+
+	vd := fd.MapValue()
+	header := []string{"key"}
+	if vd.Kind() == protoreflect.MessageKind {
+		header = append(header, columns(vd.Message())...)
+	} else {
+		header = append(header, string(fd.Name()))
+	}
+	rows := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		row := []string{k.String()}
+		if vd.Kind() == protoreflect.MessageKind {
+			row = append(row, flatten(m.Get(k).Message())...)
+		} else {
+			row = append(row, formatValue(vd, m.Get(k)))
+		}
+		rows = append(rows, row)
+	}
+	printTable(w, header, rows)
+}
+
+// columns the field names of a message, the fields of a nested message are prefixed with its name
+func columns(md protoreflect.MessageDescriptor) []string {
+	fields := md.Fields()
+	ret := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			for _, v := range columns(fd.Message()) {
+				ret = append(ret, string(fd.Name())+"."+v)
+			}
+			continue
+		}
+		ret = append(ret, string(fd.Name()))
+	}
+	return ret
+}
+
+// flatten the field values of a message in the order of columns
+func flatten(m protoreflect.Message) []string {
+	fields := m.Descriptor().Fields()
+	ret := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			ret = append(ret, flatten(m.Get(fd).Message())...)
+			continue
+		}
+		ret = append(ret, formatValue(fd, m.Get(fd)))
+	}
+	return ret
+}
+
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.IsList() {
+		list := v.List()
+		items := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			items = append(items, formatScalar(fd, list.Get(i)))
+		}
+		return strings.Join(items, ",")
+	}
+	return formatScalar(fd, v)
+}
+
+// formatScalar format a single value, the enums are printed as names
+func formatScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	default:
+		return v.String()
+	}
+}
+
+func printTable(w io.Writer, header []string, rows [][]string) {
+	fmt.Fprintln(w, strings.ToUpper(strings.Join(header, "\t")))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	fmt.Fprintln(w)
+}
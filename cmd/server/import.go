@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	eagle "github.com/go-eagle/eagle/pkg/app"
+	"github.com/go-eagle/eagle/pkg/config"
+	logger "github.com/go-eagle/eagle/pkg/log"
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/spf13/pflag"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/importer"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+// runImport import follow edges from a csv or jsonl file, it returns the exit code
+// eg: ./relation-service import -c config -e prod --file edges.csv --dry-run
+func runImport(args []string) int {
+	fs := pflag.NewFlagSet("import", pflag.ExitOnError)
+	cfgDir := fs.StringP("config dir", "c", "config", "config path.")
+	env := fs.StringP("env name", "e", "", "env var name.")
+	file := fs.String("file", "", "the file to import, - means stdin.")
+	format := fs.String("format", "", "csv or jsonl, detected by the file extension if empty.")
+	batchSize := fs.Int("batch-size", importer.DefaultBatchSize, "the number of edges written in a transaction.")
+	offset := fs.Int64("offset", 0, "skip the first n rows, use next_offset of the last report to resume.")
+	dryRun := fs.Bool("dry-run", false, "validate and count the rows without writing.")
+	_ = fs.Parse(args)
+
+	if *file == "" {
+		fmt.Fprintln(os.Stderr, "--file is required")
+		return 2
+	}
+	if *format == "" {
+		*format = detectFormat(*file)
+	}
+
+	// init config
+	c := config.New(*cfgDir, config.WithEnv(*env))
+	var cfg eagle.Config
+	if err := c.Load("app", &cfg); err != nil {
+		panic(err)
+	}
+	eagle.Conf = &cfg
+
+	// -------------- init resource -------------
+	logger.Init()
+	_, dbClean, err := model.Init()
+	if err != nil {
+		panic(err)
+	}
+	defer dbClean()
+	rdb, redisClean, err := redis.Init()
+	if err != nil {
+		panic(err)
+	}
+	defer redisClean()
+	userChecker, userCheckerClean, err := usercheck.NewUserChecker(usercheck.LoadConf())
+	if err != nil {
+		panic(err)
+	}
+	defer userCheckerClean()
+
+	var r io.Reader = os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "open file err: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+
+	db := model.GetDB()
+	cacheCfg := cache.MustLoadConf()
+	setCache := cache.NewRelationSetCache(rdb, cacheCfg)
+	breaker := cache.NewBreaker(rdb, cacheCfg)
+	invalidator, waitInvalidation := repository.NewCacheInvalidator(cacheCfg)
+	imp := importer.New(db,
+		repository.NewUserFollowing(db, cache.NewUserFollowingCache(rdb, cacheCfg), setCache, breaker, cacheCfg),
+		repository.NewUserFollower(db, cache.NewUserFollowerCache(rdb, cacheCfg), setCache, breaker, cacheCfg),
+		repository.NewUserRelationSnapshot(db),
+		userChecker,
+		invalidator,
+		importer.Options{
+			Format:    *format,
+			BatchSize: *batchSize,
+			Offset:    *offset,
+			DryRun:    *dryRun,
+		},
+	)
+
+	// stop after the current batch is committed
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	report, runErr := imp.Run(ctx, r)
+
+	// wait for the delayed cache deletion of the last batch
+	waitInvalidation()
+
+	marshaled, _ := json.MarshalIndent(report, "", "  ")
+	fmt.Println(string(marshaled))
+	if runErr != nil {
+		fmt.Fprintf(os.Stderr, "import err: %v, resume with --offset=%d\n", runErr, report.NextOffset)
+		return 1
+	}
+	return 0
+}
+
+func detectFormat(file string) string {
+	if strings.HasSuffix(file, ".jsonl") {
+		return importer.FormatJSONL
+	}
+	return importer.FormatCSV
+}
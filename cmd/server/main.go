@@ -40,6 +40,11 @@ var (
 // @host localhost:8080
 // @BasePath /v1
 func main() {
+	// import subcommand, eg: ./relation-service import --file edges.csv
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(os.Args[2:]))
+	}
+
 	pflag.Parse()
 	if *version {
 		ver := v.Get()
@@ -11,14 +11,18 @@ import (
 	"github.com/go-eagle/eagle/pkg/registry/consul"
 	"github.com/go-eagle/eagle/pkg/transport/grpc"
 	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/event"
+	"github.com/go-microservice/relation-service/internal/quota"
 	"github.com/go-microservice/relation-service/internal/repository"
 	"github.com/go-microservice/relation-service/internal/server"
 	"github.com/go-microservice/relation-service/internal/service"
+	"github.com/go-microservice/relation-service/internal/tasks"
+	"github.com/go-microservice/relation-service/internal/usercheck"
 	"github.com/google/wire"
 )
 
 func InitApp(cfg *eagle.Config, config *eagle.ServerConfig) (*eagle.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, service.ProviderSet, repository.ProviderSet, cache.ProviderSet, newApp))
+	panic(wire.Build(server.ProviderSet, service.ProviderSet, repository.ProviderSet, cache.ProviderSet, event.ProviderSet, quota.ProviderSet, usercheck.ProviderSet, tasks.NewClient, newApp))
 }
 
 func newApp(cfg *eagle.Config, gs *grpc.Server) *eagle.App {
@@ -15,10 +15,14 @@ import (
 	"github.com/go-eagle/eagle/pkg/registry/consul"
 	"github.com/go-eagle/eagle/pkg/transport/grpc"
 	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/event"
 	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/quota"
 	"github.com/go-microservice/relation-service/internal/repository"
 	"github.com/go-microservice/relation-service/internal/server"
 	"github.com/go-microservice/relation-service/internal/service"
+	"github.com/go-microservice/relation-service/internal/tasks"
+	"github.com/go-microservice/relation-service/internal/usercheck"
 )
 
 import (
@@ -33,14 +37,79 @@ func InitApp(cfg *app.Config, config *app.ServerConfig) (*app.App, func(), error
 	if err != nil {
 		return nil, nil, err
 	}
-	userFollowerCache := cache.NewUserFollowerCache(client)
-	userFollowerRepo := repository.NewUserFollower(db, userFollowerCache)
-	userFollowingCache := cache.NewUserFollowingCache(client)
-	userFollowingRepo := repository.NewUserFollowing(db, userFollowingCache)
-	relationServiceServer := service.NewRelationServiceServer(userFollowerRepo, userFollowingRepo)
-	grpcServer := server.NewGRPCServer(config, relationServiceServer)
+	cacheConfig, err := cache.LoadConf()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userFollowerCache := cache.NewUserFollowerCache(client, cacheConfig)
+	relationSetCache := cache.NewRelationSetCache(client, cacheConfig)
+	breaker := cache.NewBreaker(client, cacheConfig)
+	userFollowerRepo := repository.NewUserFollower(db, userFollowerCache, relationSetCache, breaker, cacheConfig)
+	userFollowingCache := cache.NewUserFollowingCache(client, cacheConfig)
+	userFollowingRepo := repository.NewUserFollowing(db, userFollowingCache, relationSetCache, breaker, cacheConfig)
+	followSuggestionCache := cache.NewFollowSuggestionCache(client, cacheConfig)
+	followSuggestionRepo := repository.NewFollowSuggestion(db, followSuggestionCache, cacheConfig)
+	relationGroupRepo := repository.NewRelationGroup(db)
+	relationGroupMemberRepo := repository.NewRelationGroupMember(db)
+	userCloseFriendCache := cache.NewUserCloseFriendCache(client, cacheConfig)
+	userCloseFriendRepo := repository.NewUserCloseFriend(db, userCloseFriendCache, cacheConfig)
+	relationLogRepo := repository.NewRelationLog(db)
+	followSourceStatRepo := repository.NewFollowSourceStat(db)
+	growthLeaderboardCache := cache.NewGrowthLeaderboardCache(client, cacheConfig)
+	growthLeaderboardRepo := repository.NewGrowthLeaderboard(db, growthLeaderboardCache)
+	userRelationSnapshotRepo := repository.NewUserRelationSnapshot(db)
+	userBlockRepo := repository.NewUserBlock(db)
+	cacheInvalidator, cleanup2 := repository.NewCacheInvalidator(cacheConfig)
+	eventConfig, err := event.LoadConf()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	publisher, cleanup3, err := event.NewPublisher(eventConfig)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	quotaConfig, err := quota.LoadConf()
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	usercheckConfig := usercheck.LoadConf()
+	userChecker, cleanup4, err := usercheck.NewUserChecker(usercheckConfig)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userTierProvider := quota.NewUserTierProvider(userChecker)
+	checker := quota.NewChecker(quotaConfig, userTierProvider, userFollowingRepo)
+	relationServiceServer := service.NewRelationServiceServer(userFollowerRepo, userFollowingRepo, followSuggestionRepo, relationGroupRepo, relationGroupMemberRepo, userCloseFriendRepo, relationLogRepo, followSourceStatRepo, growthLeaderboardRepo, userRelationSnapshotRepo, userBlockRepo, cacheInvalidator, publisher, checker, userChecker)
+	userRelationMergeRepo := repository.NewUserRelationMerge(db)
+	asynqClient, cleanup5, err := tasks.NewClient()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	relationAdminServiceServer := service.NewRelationAdminServiceServer(userFollowerRepo, userFollowingRepo, followSuggestionRepo, userCloseFriendRepo, userRelationSnapshotRepo, userRelationMergeRepo, relationLogRepo, cacheInvalidator, asynqClient)
+	adminConfig := server.LoadAdminConf()
+	gatewayConfig := server.LoadGatewayConf()
+	grpcServer := server.NewGRPCServer(config, relationServiceServer, relationAdminServiceServer, adminConfig, gatewayConfig)
 	appApp := newApp(cfg, grpcServer)
 	return appApp, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,284 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/go-eagle/eagle/pkg/metric"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	defaultBreakerFailureThreshold = 10
+	defaultBreakerOpenTimeout      = 5 * time.Second
+	defaultBreakerHalfOpenRequests = 3
+	defaultMaxDBConcurrency        = 100
+	defaultDBWaitTimeout           = 100 * time.Millisecond
+)
+
+// BreakerState circuit breaker state
+type BreakerState int32
+
+// breaker states, the value is exported as the metric value
+const (
+	BreakerClosed BreakerState = iota
+	BreakerHalfOpen
+	BreakerOpen
+)
+
+// String the state name
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerHalfOpen:
+		return "half-open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+var (
+	// ErrBreakerOpen redis 熔断打开, 请求未发送到 redis
+	ErrBreakerOpen = errors.New("cache: circuit breaker is open")
+	// ErrFallbackBusy 回源 DB 的并发数已满
+	ErrFallbackBusy = errors.New("cache: too many requests fall back to db")
+)
+
+var breakerStateGauge = metric.NewGaugeVec(&metric.GaugeVecOpts{
+	Namespace: "relation",
+	Subsystem: "cache_breaker",
+	Name:      "state",
+	Help:      "redis circuit breaker state, 0: closed, 1: half-open, 2: open.",
+	Labels:    []string{"name"},
+})
+
+var breakerCounter = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "relation",
+	Subsystem: "cache_breaker",
+	Name:      "requests_total",
+	Help:      "redis commands through the circuit breaker, result is success, failure or rejected.",
+	Labels:    []string{"name", "result"},
+})
+
+var fallbackCounter = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "relation",
+	Subsystem: "cache_breaker",
+	Name:      "fallback_total",
+	Help:      "requests fall back to db when cache is unavailable, result is ok or rejected.",
+	Labels:    []string{"name", "result"},
+})
+
+var (
+	breakersMu sync.Mutex
+	breakers   = make(map[*redis.Client]*Breaker)
+)
+
+// Breaker circuit breaker around redis, it is installed as a redis hook so that
+// all the caches built on the same client share it.
+//
+// closed: 连续失败 FailureThreshold 次后打开
+// open: 所有命令直接返回 ErrBreakerOpen, OpenTimeout 后进入半开
+// half-open: 放行 HalfOpenRequests 个命令, 全部成功则关闭, 任意失败则重新打开
+type Breaker struct {
+	name string
+	cfg  BreakerConfig
+	sem  *semaphore.Weighted
+
+	mu        sync.Mutex
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	probes    int
+	successes int
+}
+
+// NewBreaker get the breaker of the redis client, the hook is installed only once for a client
+// so it is safe to call it in every repo.
+func NewBreaker(rdb *redis.Client, cfg *Config) *Breaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	if b, ok := breakers[rdb]; ok {
+		return b
+	}
+	c := BreakerConfig{}
+	if cfg != nil {
+		c = cfg.Breaker
+	}
+	b := newBreaker(rdb.Options().Addr, c)
+	if b.cfg.Enable {
+		rdb.AddHook(breakerHook{b: b})
+	}
+	breakers[rdb] = b
+	return b
+}
+
+func newBreaker(name string, cfg BreakerConfig) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaultBreakerFailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = defaultBreakerOpenTimeout
+	}
+	if cfg.HalfOpenRequests <= 0 {
+		cfg.HalfOpenRequests = defaultBreakerHalfOpenRequests
+	}
+	if cfg.MaxDBConcurrency <= 0 {
+		cfg.MaxDBConcurrency = defaultMaxDBConcurrency
+	}
+	if cfg.DBWaitTimeout <= 0 {
+		cfg.DBWaitTimeout = defaultDBWaitTimeout
+	}
+	b := &Breaker{
+		name: name,
+		cfg:  cfg,
+		sem:  semaphore.NewWeighted(int64(cfg.MaxDBConcurrency)),
+	}
+	breakerStateGauge.Set(float64(BreakerClosed), name)
+	return b
+}
+
+// BreakerStates the state of all the breakers, key is the redis addr
+func BreakerStates() map[string]string {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	states := make(map[string]string, len(breakers))
+	for _, b := range breakers {
+		states[b.name] = b.State().String()
+	}
+	return states
+}
+
+// State the current state
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Fallback run fn which reads from DB when cache is unavailable, the concurrency is bounded
+// to protect DB from the traffic which is usually served by cache.
+func (b *Breaker) Fallback(ctx context.Context, fn func() error) error {
+	waitCtx, cancel := context.WithTimeout(ctx, b.cfg.DBWaitTimeout)
+	defer cancel()
+	if err := b.sem.Acquire(waitCtx, 1); err != nil {
+		fallbackCounter.Inc(b.name, "rejected")
+		return ErrFallbackBusy
+	}
+	defer b.sem.Release(1)
+
+	fallbackCounter.Inc(b.name, "ok")
+	return fn()
+}
+
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cfg.OpenTimeout {
+			return ErrBreakerOpen
+		}
+		b.probes, b.successes = 0, 0
+		b.setState(BreakerHalfOpen)
+		fallthrough
+	case BreakerHalfOpen:
+		if b.probes >= b.cfg.HalfOpenRequests {
+			return ErrBreakerOpen
+		}
+		b.probes++
+	}
+	return nil
+}
+
+func (b *Breaker) mark(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if isBreakerFailure(err) {
+		breakerCounter.Inc(b.name, "failure")
+		b.failures++
+		if b.state == BreakerHalfOpen || b.failures >= b.cfg.FailureThreshold {
+			b.openedAt = time.Now()
+			b.setState(BreakerOpen)
+		}
+		return
+	}
+
+	breakerCounter.Inc(b.name, "success")
+	b.failures = 0
+	if b.state == BreakerHalfOpen {
+		b.successes++
+		if b.successes >= b.cfg.HalfOpenRequests {
+			b.setState(BreakerClosed)
+		}
+	}
+}
+
+// setState must be called with the lock held
+func (b *Breaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	log.Warnf("[cache] redis circuit breaker %s: %s -> %s", b.name, b.state, state)
+	b.state = state
+	b.failures = 0
+	breakerStateGauge.Set(float64(state), b.name)
+}
+
+// isBreakerFailure only the errors which mean redis is unavailable are counted,
+// eg: a miss or a WRONGTYPE reply is a success.
+func isBreakerFailure(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return false
+	}
+	// canceled by the caller
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var replyErr redis.Error
+	return !errors.As(err, &replyErr)
+}
+
+type breakerHook struct {
+	b *Breaker
+}
+
+func (h breakerHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h breakerHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.b.allow(); err != nil {
+			breakerCounter.Inc(h.b.name, "rejected")
+			cmd.SetErr(err)
+			return err
+		}
+		err := next(ctx, cmd)
+		h.b.mark(err)
+		return err
+	}
+}
+
+func (h breakerHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.b.allow(); err != nil {
+			breakerCounter.Inc(h.b.name, "rejected")
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		err := next(ctx, cmds)
+		h.b.mark(err)
+		return err
+	}
+}
@@ -6,4 +6,4 @@ import (
 )
 
 // ProviderSet is cache providers.
-var ProviderSet = wire.NewSet(redis.Init, NewUserFollowerCache, NewUserFollowingCache)
+var ProviderSet = wire.NewSet(redis.Init, NewUserFollowerCache, NewUserFollowingCache, NewRelationSetCache, NewFollowSuggestionCache, NewUserCloseFriendCache, NewGrowthLeaderboardCache, LoadConf, NewBreaker)
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	"github.com/go-eagle/eagle/pkg/log"
+)
+
+// Config cache config, see config/{env}/cache.yaml
+type Config struct {
+	// Prefix key 的命名空间, 多个环境共用一个 redis 时用于区分, eg: prod, staging
+	Prefix string
+	// Encoding 写入缓存的序列化格式: json, msgpack, proto, 读取时兼容所有格式
+	Encoding string
+	// TTL 各类缓存的过期时间
+	TTL TTLConfig
+	// TTLJitter 过期时间随机增加的比例, 避免同一时间写入的 key 同时过期, eg: 0.1 表示增加 0~10%
+	TTLJitter float64
+	// LocalCache 进程内热点 key 缓存
+	LocalCache LocalCacheConfig
+	// DoubleDeleteDelay 事务提交后延迟二次删除缓存的间隔
+	DoubleDeleteDelay time.Duration
+	// Breaker redis 熔断, 打开后缓存操作直接失败并回源到 DB
+	Breaker BreakerConfig
+}
+
+// TTLConfig cache ttl config
+type TTLConfig struct {
+	UserFollowing    time.Duration
+	UserFollower     time.Duration
+	UserCloseFriend  time.Duration
+	RelationSet      time.Duration
+	FollowSuggestion time.Duration
+}
+
+// LocalCacheConfig local cache config
+type LocalCacheConfig struct {
+	Enable bool
+	// HotKeyThreshold 统计窗口内访问次数达到该值的 key 视为热点
+	HotKeyThreshold int
+	// HotKeyWindow 热点统计窗口
+	HotKeyWindow time.Duration
+	// TTL 本地缓存时间, 其他实例的修改只能等过期后才可见, 不宜过长
+	TTL time.Duration
+	// MaxKeys 最多缓存的 key 数量
+	MaxKeys int64
+}
+
+// BreakerConfig redis circuit breaker config
+type BreakerConfig struct {
+	Enable bool
+	// FailureThreshold 连续失败多少次后打开熔断
+	FailureThreshold int
+	// OpenTimeout 熔断打开后多久进入半开状态, 放行少量请求探测 redis 是否恢复
+	OpenTimeout time.Duration
+	// HalfOpenRequests 半开状态放行的请求数, 全部成功后关闭熔断
+	HalfOpenRequests int
+	// MaxDBConcurrency 缓存不可用时回源 DB 的最大并发数
+	MaxDBConcurrency int
+	// DBWaitTimeout 等待回源 DB 的最长时间, 超时后请求直接失败
+	DBWaitTimeout time.Duration
+}
+
+// DefaultConfig the config used when cache.yaml is absent
+func DefaultConfig() *Config {
+	c := &Config{}
+	c.setDefaults()
+	return c
+}
+
+// LoadConf load cache config
+func LoadConf() (*Config, error) {
+	v, err := config.LoadWithType("cache", "yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := v.Unmarshal(&c); err != nil {
+		return nil, err
+	}
+	c.setDefaults()
+	return &c, nil
+}
+
+// MustLoadConf load cache config, the default config is used if it fails,
+// it is used by the tools which do not require cache.yaml.
+func MustLoadConf() *Config {
+	c, err := LoadConf()
+	if err != nil {
+		log.Warnf("load cache config err: %v, use the default config", err)
+		return DefaultConfig()
+	}
+	return c
+}
+
+func (c *Config) setDefaults() {
+	if c.Encoding == "" {
+		c.Encoding = EncodingJSON
+	}
+	if c.TTL.UserFollowing <= 0 {
+		c.TTL.UserFollowing = 5 * time.Minute
+	}
+	if c.TTL.UserFollower <= 0 {
+		c.TTL.UserFollower = 5 * time.Minute
+	}
+	if c.TTL.UserCloseFriend <= 0 {
+		c.TTL.UserCloseFriend = 5 * time.Minute
+	}
+	if c.TTL.RelationSet <= 0 {
+		c.TTL.RelationSet = 10 * time.Minute
+	}
+	if c.TTL.FollowSuggestion <= 0 {
+		c.TTL.FollowSuggestion = 12 * time.Hour
+	}
+}
+
+// BuildKey add the namespace prefix to the key, the same as the redis cache of eagle
+func (c *Config) BuildKey(key string) string {
+	if c == nil || c.Prefix == "" {
+		return key
+	}
+	return c.Prefix + ":" + key
+}
+
+// Jitter add a random duration to the ttl
+func (c *Config) Jitter(ttl time.Duration) time.Duration {
+	if c == nil || c.TTLJitter <= 0 || ttl <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Float64()*c.TTLJitter*float64(ttl))
+}
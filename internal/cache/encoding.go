@@ -0,0 +1,222 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/encoding"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-microservice/relation-service/internal/cache/cachepb"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// EncodingJSON json 格式, 默认值
+	EncodingJSON = "json"
+	// EncodingMsgPack msgpack 格式
+	EncodingMsgPack = "msgpack"
+	// EncodingProto protobuf 格式, 没有 proto 定义的类型仍使用 json
+	EncodingProto = "proto"
+)
+
+// the first byte of a non-json value is the format tag,
+// a json value always starts with a printable character.
+const (
+	formatTagMsgPack byte = 0x01
+	formatTagProto   byte = 0x02
+)
+
+var msgPackEncoding = encoding.MsgPackEncoding{}
+
+// versionedEncoding write the values in the configured format and read the values of all formats,
+// so the encoding can be switched without mass cache misses:
+// first roll out the version which can read the new format, then switch the config.
+// json values are written without tag to keep compatible with the old version.
+type versionedEncoding struct {
+	format string
+}
+
+// newEncoding new an encoding of the format
+func newEncoding(format string) encoding.Encoding {
+	switch format {
+	case "", EncodingJSON, EncodingMsgPack, EncodingProto:
+	default:
+		panic(fmt.Sprintf("unknown cache encoding: %s", format))
+	}
+	return versionedEncoding{format: format}
+}
+
+// Marshal encode the value with the format tag
+func (e versionedEncoding) Marshal(v interface{}) ([]byte, error) {
+	switch e.format {
+	case EncodingMsgPack:
+		buf, err := msgPackEncoding.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{formatTagMsgPack}, buf...), nil
+	case EncodingProto:
+		if m := toProto(v); m != nil {
+			buf, err := proto.Marshal(m)
+			if err != nil {
+				return nil, err
+			}
+			return append([]byte{formatTagProto}, buf...), nil
+		}
+	}
+	return json.Marshal(v)
+}
+
+// Unmarshal decode the value by the format tag
+func (e versionedEncoding) Unmarshal(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	switch data[0] {
+	case formatTagMsgPack:
+		return msgPackEncoding.Unmarshal(data[1:], v)
+	case formatTagProto:
+		return fromProto(data[1:], v)
+	default:
+		return json.Unmarshal(data, v)
+	}
+}
+
+// toProto convert the value to proto message, nil is returned if it has no proto definition
+func toProto(v interface{}) proto.Message {
+	switch data := v.(type) {
+	case *model.UserFollowingModel:
+		return &cachepb.UserFollowing{
+			Id:          data.ID,
+			UserId:      data.UserID,
+			FollowedUid: data.FollowedUID,
+			Status:      int32(data.Status),
+			Remark:      data.Remark,
+			IsSpecial:   data.IsSpecial,
+			IsMuted:     data.IsMuted,
+			Source:      int32(data.Source),
+			SourceMeta:  data.SourceMeta,
+			CreatedAt:   unixNano(data.CreatedAt),
+			UpdatedAt:   unixNano(data.UpdatedAt),
+		}
+	case *model.UserFollowerModel:
+		return &cachepb.UserFollower{
+			Id:          data.ID,
+			UserId:      data.UserID,
+			FollowerUid: data.FollowerUID,
+			Status:      int32(data.Status),
+			CreatedAt:   unixNano(data.CreatedAt),
+			UpdatedAt:   unixNano(data.UpdatedAt),
+		}
+	case *model.UserCloseFriendModel:
+		return &cachepb.UserCloseFriend{
+			Id:        data.ID,
+			UserId:    data.UserID,
+			FriendUid: data.FriendUID,
+			Status:    int32(data.Status),
+			CreatedAt: unixNano(data.CreatedAt),
+			UpdatedAt: unixNano(data.UpdatedAt),
+		}
+	case *[]*model.FollowSuggestion:
+		list := &cachepb.FollowSuggestionList{}
+		for _, item := range *data {
+			list.Items = append(list.Items, &cachepb.FollowSuggestionListItem{
+				Uid:         item.UID,
+				MutualCount: item.MutualCount,
+			})
+		}
+		return list
+	}
+	return nil
+}
+
+// fromProto decode the proto message into the value
+func fromProto(buf []byte, v interface{}) error {
+	// the caches read into a pointer of pointer, eg: **model.UserFollowingModel
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Ptr {
+		if rv.Elem().IsNil() {
+			rv.Elem().Set(reflect.New(rv.Elem().Type().Elem()))
+		}
+		return fromProto(buf, rv.Elem().Interface())
+	}
+
+	switch data := v.(type) {
+	case *model.UserFollowingModel:
+		m := &cachepb.UserFollowing{}
+		if err := proto.Unmarshal(buf, m); err != nil {
+			return err
+		}
+		*data = model.UserFollowingModel{
+			ID:          m.Id,
+			UserID:      m.UserId,
+			FollowedUID: m.FollowedUid,
+			Status:      int(m.Status),
+			Remark:      m.Remark,
+			IsSpecial:   m.IsSpecial,
+			IsMuted:     m.IsMuted,
+			Source:      int(m.Source),
+			SourceMeta:  m.SourceMeta,
+			CreatedAt:   fromUnixNano(m.CreatedAt),
+			UpdatedAt:   fromUnixNano(m.UpdatedAt),
+		}
+	case *model.UserFollowerModel:
+		m := &cachepb.UserFollower{}
+		if err := proto.Unmarshal(buf, m); err != nil {
+			return err
+		}
+		*data = model.UserFollowerModel{
+			ID:          m.Id,
+			UserID:      m.UserId,
+			FollowerUID: m.FollowerUid,
+			Status:      int(m.Status),
+			CreatedAt:   fromUnixNano(m.CreatedAt),
+			UpdatedAt:   fromUnixNano(m.UpdatedAt),
+		}
+	case *model.UserCloseFriendModel:
+		m := &cachepb.UserCloseFriend{}
+		if err := proto.Unmarshal(buf, m); err != nil {
+			return err
+		}
+		*data = model.UserCloseFriendModel{
+			ID:        m.Id,
+			UserID:    m.UserId,
+			FriendUID: m.FriendUid,
+			Status:    int(m.Status),
+			CreatedAt: fromUnixNano(m.CreatedAt),
+			UpdatedAt: fromUnixNano(m.UpdatedAt),
+		}
+	case *[]*model.FollowSuggestion:
+		m := &cachepb.FollowSuggestionList{}
+		if err := proto.Unmarshal(buf, m); err != nil {
+			return err
+		}
+		list := make([]*model.FollowSuggestion, 0, len(m.Items))
+		for _, item := range m.Items {
+			list = append(list, &model.FollowSuggestion{
+				UID:         item.Uid,
+				MutualCount: item.MutualCount,
+			})
+		}
+		*data = list
+	default:
+		return fmt.Errorf("type %T has no proto definition", v)
+	}
+	return nil
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func fromUnixNano(n int64) time.Time {
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
@@ -0,0 +1,82 @@
+package cache
+
+//go:generate mockgen -source=internal/cache/follow_suggestion_cache.go -destination=internal/mock/follow_suggestion_cache_mock.go  -package mock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/cache"
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// PrefixFollowSuggestionCacheKey cache prefix
+	PrefixFollowSuggestionCacheKey = "user:suggest:%d"
+)
+
+// FollowSuggestionCache define cache interface
+type FollowSuggestionCache interface {
+	SetFollowSuggestionCache(ctx context.Context, userID int64, data []*model.FollowSuggestion, duration time.Duration) error
+	GetFollowSuggestionCache(ctx context.Context, userID int64) (data []*model.FollowSuggestion, err error)
+	DelFollowSuggestionCache(ctx context.Context, userID int64) error
+}
+
+// followSuggestionCache define cache struct
+type followSuggestionCache struct {
+	cache cache.Cache
+	cfg   *Config
+}
+
+// NewFollowSuggestionCache new a cache
+func NewFollowSuggestionCache(rdb *redis.Client, cfg *Config) FollowSuggestionCache {
+	return &followSuggestionCache{
+		cache: cache.NewRedisCache(rdb, cfg.Prefix, newEncoding(cfg.Encoding), func() interface{} {
+			return &[]*model.FollowSuggestion{}
+		}),
+		cfg: cfg,
+	}
+}
+
+// GetFollowSuggestionCacheKey get cache key
+func (c *followSuggestionCache) GetFollowSuggestionCacheKey(userID int64) string {
+	return fmt.Sprintf(PrefixFollowSuggestionCacheKey, userID)
+}
+
+// SetFollowSuggestionCache write to cache
+func (c *followSuggestionCache) SetFollowSuggestionCache(ctx context.Context, userID int64, data []*model.FollowSuggestion, duration time.Duration) error {
+	if data == nil || userID == 0 {
+		return nil
+	}
+	cacheKey := c.GetFollowSuggestionCacheKey(userID)
+	err := c.cache.Set(ctx, cacheKey, &data, c.cfg.Jitter(duration))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetFollowSuggestionCache get from cache
+func (c *followSuggestionCache) GetFollowSuggestionCache(ctx context.Context, userID int64) (data []*model.FollowSuggestion, err error) {
+	cacheKey := c.GetFollowSuggestionCacheKey(userID)
+	err = c.cache.Get(ctx, cacheKey, &data)
+	if err != nil {
+		log.WithContext(ctx).Warnf("get err from redis, err: %+v", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+// DelFollowSuggestionCache delete cache
+func (c *followSuggestionCache) DelFollowSuggestionCache(ctx context.Context, userID int64) error {
+	cacheKey := c.GetFollowSuggestionCacheKey(userID)
+	err := c.cache.Del(ctx, cacheKey)
+	if err != nil {
+		return err
+	}
+	return nil
+}
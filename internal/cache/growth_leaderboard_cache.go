@@ -0,0 +1,137 @@
+package cache
+
+//go:generate mockgen -source=internal/cache/growth_leaderboard_cache.go -destination=internal/mock/growth_leaderboard_cache_mock.go  -package mock
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// PrefixGrowthBucketCacheKey 每小时一个桶, 存储 uid -> 粉丝净增长数
+	PrefixGrowthBucketCacheKey = "relation:growth:%s"
+	// PrefixGrowthTopCacheKey 合并后的排行榜, 按窗口小时数区分
+	PrefixGrowthTopCacheKey = "relation:growth:top:%d"
+
+	// GrowthBucketSize 桶的时间跨度
+	GrowthBucketSize = time.Hour
+	// GrowthBucketTTL 桶的过期时间, 需要大于最大的统计窗口
+	GrowthBucketTTL = 7*24*time.Hour + 2*time.Hour
+	// growthTopTTL 合并结果的缓存时间
+	growthTopTTL = time.Minute
+	// growthBucketMarker 重建时写入桶的占位成员, 分数为 0, 使没有增长的桶也存在, 合并时会被过滤掉
+	growthBucketMarker = "0"
+)
+
+// GrowthLeaderboardCache define cache interface
+type GrowthLeaderboardCache interface {
+	IncrGrowthCache(ctx context.Context, uid, delta int64, at time.Time) error
+	SetGrowthBucketCache(ctx context.Context, bucket time.Time, growth map[int64]int64) error
+	ExistsGrowthBucketCache(ctx context.Context, bucket time.Time) (bool, error)
+	// GetTopGrowthCache merge the buckets in window and return the top accounts
+	GetTopGrowthCache(ctx context.Context, window time.Duration, limit int) ([]*model.AccountGrowth, error)
+}
+
+// growthLeaderboardCache define cache struct
+type growthLeaderboardCache struct {
+	rdb *redis.Client
+	cfg *Config
+}
+
+// NewGrowthLeaderboardCache new a cache
+func NewGrowthLeaderboardCache(rdb *redis.Client, cfg *Config) GrowthLeaderboardCache {
+	return &growthLeaderboardCache{
+		rdb: rdb,
+		cfg: cfg,
+	}
+}
+
+// GetGrowthBucketCacheKey get cache key
+func (c *growthLeaderboardCache) GetGrowthBucketCacheKey(bucket time.Time) string {
+	return c.cfg.BuildKey(fmt.Sprintf(PrefixGrowthBucketCacheKey, bucket.Truncate(GrowthBucketSize).Format("2006010215")))
+}
+
+// GetGrowthTopCacheKey get cache key
+func (c *growthLeaderboardCache) GetGrowthTopCacheKey(window time.Duration) string {
+	return c.cfg.BuildKey(fmt.Sprintf(PrefixGrowthTopCacheKey, int64(window/GrowthBucketSize)))
+}
+
+// IncrGrowthCache incr the growth in the bucket of the time, delta is negative for unfollow
+func (c *growthLeaderboardCache) IncrGrowthCache(ctx context.Context, uid, delta int64, at time.Time) error {
+	cacheKey := c.GetGrowthBucketCacheKey(at)
+	pipe := c.rdb.Pipeline()
+	pipe.ZIncrBy(ctx, cacheKey, float64(delta), strconv.FormatInt(uid, 10))
+	pipe.Expire(ctx, cacheKey, GrowthBucketTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// SetGrowthBucketCache overwrite the whole bucket, a marker is added so that the empty bucket is not rebuilt again
+func (c *growthLeaderboardCache) SetGrowthBucketCache(ctx context.Context, bucket time.Time, growth map[int64]int64) error {
+	members := make([]redis.Z, 0, len(growth)+1)
+	members = append(members, redis.Z{Score: 0, Member: growthBucketMarker})
+	for uid, v := range growth {
+		members = append(members, redis.Z{Score: float64(v), Member: strconv.FormatInt(uid, 10)})
+	}
+	cacheKey := c.GetGrowthBucketCacheKey(bucket)
+	pipe := c.rdb.TxPipeline()
+	pipe.Del(ctx, cacheKey)
+	pipe.ZAdd(ctx, cacheKey, members...)
+	pipe.Expire(ctx, cacheKey, GrowthBucketTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ExistsGrowthBucketCache check the bucket if is exist
+func (c *growthLeaderboardCache) ExistsGrowthBucketCache(ctx context.Context, bucket time.Time) (bool, error) {
+	n, err := c.rdb.Exists(ctx, c.GetGrowthBucketCacheKey(bucket)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// GetTopGrowthCache get from cache
+func (c *growthLeaderboardCache) GetTopGrowthCache(ctx context.Context, window time.Duration, limit int) ([]*model.AccountGrowth, error) {
+	topKey := c.GetGrowthTopCacheKey(window)
+	n, err := c.rdb.Exists(ctx, topKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		now := time.Now()
+		keys := make([]string, 0, int(window/GrowthBucketSize))
+		for t := now; now.Sub(t) < window; t = t.Add(-GrowthBucketSize) {
+			keys = append(keys, c.GetGrowthBucketCacheKey(t))
+		}
+		pipe := c.rdb.TxPipeline()
+		pipe.ZUnionStore(ctx, topKey, &redis.ZStore{Keys: keys, Aggregate: "SUM"})
+		// only growing accounts are ranked
+		pipe.ZRemRangeByScore(ctx, topKey, "-inf", "0")
+		pipe.Expire(ctx, topKey, growthTopTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := c.rdb.ZRevRangeWithScores(ctx, topKey, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*model.AccountGrowth, 0, len(items))
+	for _, v := range items {
+		member, _ := v.Member.(string)
+		uid, err := strconv.ParseInt(member, 10, 64)
+		if err != nil || uid == 0 {
+			continue
+		}
+		ret = append(ret, &model.AccountGrowth{UID: uid, Growth: int64(v.Score)})
+	}
+	return ret, nil
+}
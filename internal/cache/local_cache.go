@@ -0,0 +1,210 @@
+package cache
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/go-eagle/eagle/pkg/metric"
+)
+
+const (
+	hotKeyShardNum = 32
+
+	defaultHotKeyThreshold = 100
+	defaultHotKeyWindow    = time.Second
+	defaultLocalCacheTTL   = 2 * time.Second
+	defaultLocalCacheKeys  = 10000
+	// maxLocalPages the max pages of a list kept in local cache
+	maxLocalPages = 16
+)
+
+var localCacheCounter = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "relation",
+	Subsystem: "local_cache",
+	Name:      "requests_total",
+	Help:      "local hot key cache requests, result is hit or miss.",
+	Labels:    []string{"name", "result"},
+})
+
+var hotKeyCounter = metric.NewCounterVec(&metric.CounterVecOpts{
+	Namespace: "relation",
+	Subsystem: "local_cache",
+	Name:      "hot_keys_total",
+	Help:      "keys stored into local cache after being detected as hot.",
+	Labels:    []string{"name"},
+})
+
+// LocalCache in-process cache which only keeps the hot keys,
+// the cached values are shared between requests and must not be modified.
+type LocalCache interface {
+	// Get record an access of the key and return the value if it is cached
+	Get(key string) (interface{}, bool)
+	// Set store the value only when the key is hot
+	Set(key string, val interface{})
+	Del(key string)
+}
+
+// NewLocalCache new a local cache, a no-op cache is returned if it is disabled.
+// name is used as the metric label.
+func NewLocalCache(name string, cfg *Config) LocalCache {
+	if cfg == nil || !cfg.LocalCache.Enable {
+		return nopLocalCache{}
+	}
+	c := cfg.LocalCache
+	if c.HotKeyThreshold <= 0 {
+		c.HotKeyThreshold = defaultHotKeyThreshold
+	}
+	if c.HotKeyWindow <= 0 {
+		c.HotKeyWindow = defaultHotKeyWindow
+	}
+	if c.TTL <= 0 {
+		c.TTL = defaultLocalCacheTTL
+	}
+	if c.MaxKeys <= 0 {
+		c.MaxKeys = defaultLocalCacheKeys
+	}
+
+	// every item costs 1, so MaxCost is the max number of keys
+	store, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: c.MaxKeys * 10,
+		MaxCost:     c.MaxKeys,
+		BufferItems: 64,
+	})
+	if err != nil {
+		panic(err)
+	}
+	lc := &localCache{
+		name:   name,
+		store:  store,
+		ttl:    c.TTL,
+		detect: newHotKeyDetector(c.HotKeyThreshold, c.HotKeyWindow),
+	}
+	return lc
+}
+
+type localCache struct {
+	name   string
+	store  *ristretto.Cache
+	ttl    time.Duration
+	detect *hotKeyDetector
+}
+
+// Get from local cache
+func (c *localCache) Get(key string) (interface{}, bool) {
+	c.detect.Hit(key)
+	val, ok := c.store.Get(key)
+	if !ok {
+		localCacheCounter.Inc(c.name, "miss")
+		return nil, false
+	}
+	localCacheCounter.Inc(c.name, "hit")
+	return val, true
+}
+
+// Set write to local cache if the key is hot
+func (c *localCache) Set(key string, val interface{}) {
+	if !c.detect.IsHot(key) {
+		return
+	}
+	if c.store.SetWithTTL(key, val, 1, c.ttl) {
+		hotKeyCounter.Inc(c.name)
+	}
+}
+
+// Del delete from local cache, only the current instance is affected
+func (c *localCache) Del(key string) {
+	c.store.Del(key)
+}
+
+// LocalPages the cached pages of a list, stored under one key so that they are deleted together by Del(key)
+type LocalPages map[string]interface{}
+
+// GetLocalPage get a page of the list from local cache, the pages are returned to be passed to SetLocalPage
+func GetLocalPage(c LocalCache, key, page string) (interface{}, LocalPages, bool) {
+	val, ok := c.Get(key)
+	if !ok {
+		return nil, nil, false
+	}
+	pages, _ := val.(LocalPages)
+	v, ok := pages[page]
+	return v, pages, ok
+}
+
+// SetLocalPage add a page into the pages of the list, pages is copied since the cached value is shared
+func SetLocalPage(c LocalCache, key string, pages LocalPages, page string, val interface{}) {
+	newPages := make(LocalPages, len(pages)+1)
+	// the deep pages are rarely read again, start over instead of growing without limit
+	if len(pages) < maxLocalPages {
+		for k, v := range pages {
+			newPages[k] = v
+		}
+	}
+	newPages[page] = val
+	c.Set(key, newPages)
+}
+
+type nopLocalCache struct{}
+
+func (nopLocalCache) Get(string) (interface{}, bool) { return nil, false }
+func (nopLocalCache) Set(string, interface{})        {}
+func (nopLocalCache) Del(string)                     {}
+
+// hotKeyDetector count the accesses of keys in a fixed window,
+// counters are sharded to reduce lock contention.
+type hotKeyDetector struct {
+	shards    [hotKeyShardNum]*hotKeyShard
+	threshold int
+	window    time.Duration
+}
+
+type hotKeyShard struct {
+	sync.Mutex
+	counts  map[string]int
+	resetAt time.Time
+}
+
+func newHotKeyDetector(threshold int, window time.Duration) *hotKeyDetector {
+	d := &hotKeyDetector{
+		threshold: threshold,
+		window:    window,
+	}
+	for i := range d.shards {
+		d.shards[i] = &hotKeyShard{counts: make(map[string]int)}
+	}
+	return d
+}
+
+// Hit record an access of the key
+func (d *hotKeyDetector) Hit(key string) {
+	s := d.shard(key)
+	s.Lock()
+	d.resetIfExpired(s)
+	s.counts[key]++
+	s.Unlock()
+}
+
+// IsHot report whether the key is accessed frequently in current window
+func (d *hotKeyDetector) IsHot(key string) bool {
+	s := d.shard(key)
+	s.Lock()
+	defer s.Unlock()
+	d.resetIfExpired(s)
+	return s.counts[key] >= d.threshold
+}
+
+func (d *hotKeyDetector) shard(key string) *hotKeyShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return d.shards[h.Sum32()%hotKeyShardNum]
+}
+
+func (d *hotKeyDetector) resetIfExpired(s *hotKeyShard) {
+	now := time.Now()
+	if now.Before(s.resetAt) {
+		return
+	}
+	s.counts = make(map[string]int, len(s.counts))
+	s.resetAt = now.Add(d.window)
+}
@@ -0,0 +1,186 @@
+package cache
+
+//go:generate mockgen -source=internal/cache/relation_set_cache.go -destination=internal/mock/relation_set_cache_mock.go  -package mock
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// PrefixFollowingSetCacheKey 关注的 uid 集合
+	PrefixFollowingSetCacheKey = "user:following:set:%d"
+	// PrefixFollowerSetCacheKey 粉丝的 uid 集合
+	PrefixFollowerSetCacheKey = "user:follower:set:%d"
+	// PrefixFollowingSetOversizeKey 关注数超过集合上限的标记, 有标记时不再尝试加载集合
+	PrefixFollowingSetOversizeKey = "user:following:set:oversize:%d"
+	// PrefixFollowerSetOversizeKey 粉丝数超过集合上限的标记
+	PrefixFollowerSetOversizeKey = "user:follower:set:oversize:%d"
+)
+
+// commonFollowersScript returns the intersection only when both sets are warm,
+// a cold set would otherwise be treated as an empty list.
+var commonFollowersScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 or redis.call("EXISTS", KEYS[2]) == 0 then
+	return false
+end
+return redis.call("SINTER", KEYS[1], KEYS[2])
+`)
+
+// countCommonFollowersScript the same as commonFollowersScript but only the cardinality is returned,
+// SINTERCARD requires redis 7.0
+var countCommonFollowersScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 or redis.call("EXISTS", KEYS[2]) == 0 then
+	return false
+end
+return redis.call("SINTERCARD", 2, KEYS[1], KEYS[2])
+`)
+
+// RelationSetCache define cache interface for relation uid sets
+type RelationSetCache interface {
+	SetFollowingSetCache(ctx context.Context, userID int64, uids []int64, duration time.Duration) error
+	DelFollowingSetCache(ctx context.Context, userID int64) error
+	SetFollowerSetCache(ctx context.Context, userID int64, uids []int64, duration time.Duration) error
+	DelFollowerSetCache(ctx context.Context, userID int64) error
+	// GetCommonFollowersCache intersect viewer's following set with target's follower set,
+	// ok is false if any of the sets is not in cache
+	GetCommonFollowersCache(ctx context.Context, viewerID, targetID int64) (uids []int64, ok bool, err error)
+	// CountCommonFollowersCache the same as GetCommonFollowersCache but only the count is returned
+	CountCommonFollowersCache(ctx context.Context, viewerID, targetID int64) (count int64, ok bool, err error)
+	// SetFollowingSetOversize mark the followings of the user too many to be cached as a set
+	SetFollowingSetOversize(ctx context.Context, userID int64, duration time.Duration) error
+	// SetFollowerSetOversize mark the followers of the user too many to be cached as a set
+	SetFollowerSetOversize(ctx context.Context, userID int64, duration time.Duration) error
+	// GetSetOversize whether the following set of followingUID and the follower set of followerUID are marked oversize
+	GetSetOversize(ctx context.Context, followingUID, followerUID int64) (followingOversize, followerOversize bool, err error)
+}
+
+// relationSetCache define cache struct
+type relationSetCache struct {
+	rdb *redis.Client
+	cfg *Config
+}
+
+// NewRelationSetCache new a cache
+func NewRelationSetCache(rdb *redis.Client, cfg *Config) RelationSetCache {
+	return &relationSetCache{
+		rdb: rdb,
+		cfg: cfg,
+	}
+}
+
+// GetFollowingSetCacheKey get cache key
+func (c *relationSetCache) GetFollowingSetCacheKey(userID int64) string {
+	return c.cfg.BuildKey(fmt.Sprintf(PrefixFollowingSetCacheKey, userID))
+}
+
+// GetFollowerSetCacheKey get cache key
+func (c *relationSetCache) GetFollowerSetCacheKey(userID int64) string {
+	return c.cfg.BuildKey(fmt.Sprintf(PrefixFollowerSetCacheKey, userID))
+}
+
+// GetFollowingSetOversizeKey get cache key
+func (c *relationSetCache) GetFollowingSetOversizeKey(userID int64) string {
+	return c.cfg.BuildKey(fmt.Sprintf(PrefixFollowingSetOversizeKey, userID))
+}
+
+// GetFollowerSetOversizeKey get cache key
+func (c *relationSetCache) GetFollowerSetOversizeKey(userID int64) string {
+	return c.cfg.BuildKey(fmt.Sprintf(PrefixFollowerSetOversizeKey, userID))
+}
+
+// SetFollowingSetCache write to cache
+func (c *relationSetCache) SetFollowingSetCache(ctx context.Context, userID int64, uids []int64, duration time.Duration) error {
+	return c.setSet(ctx, c.GetFollowingSetCacheKey(userID), uids, duration)
+}
+
+// DelFollowingSetCache delete cache
+func (c *relationSetCache) DelFollowingSetCache(ctx context.Context, userID int64) error {
+	return c.rdb.Del(ctx, c.GetFollowingSetCacheKey(userID)).Err()
+}
+
+// SetFollowerSetCache write to cache
+func (c *relationSetCache) SetFollowerSetCache(ctx context.Context, userID int64, uids []int64, duration time.Duration) error {
+	return c.setSet(ctx, c.GetFollowerSetCacheKey(userID), uids, duration)
+}
+
+// DelFollowerSetCache delete cache
+func (c *relationSetCache) DelFollowerSetCache(ctx context.Context, userID int64) error {
+	return c.rdb.Del(ctx, c.GetFollowerSetCacheKey(userID)).Err()
+}
+
+// GetCommonFollowersCache get from cache
+func (c *relationSetCache) GetCommonFollowersCache(ctx context.Context, viewerID, targetID int64) ([]int64, bool, error) {
+	keys := []string{c.GetFollowingSetCacheKey(viewerID), c.GetFollowerSetCacheKey(targetID)}
+	members, err := commonFollowersScript.Run(ctx, c.rdb, keys).StringSlice()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	uids := make([]int64, 0, len(members))
+	for _, m := range members {
+		uid, err := strconv.ParseInt(m, 10, 64)
+		if err != nil {
+			continue
+		}
+		uids = append(uids, uid)
+	}
+	return uids, true, nil
+}
+
+// CountCommonFollowersCache get the count from cache, the members are not transferred
+func (c *relationSetCache) CountCommonFollowersCache(ctx context.Context, viewerID, targetID int64) (int64, bool, error) {
+	keys := []string{c.GetFollowingSetCacheKey(viewerID), c.GetFollowerSetCacheKey(targetID)}
+	count, err := countCommonFollowersScript.Run(ctx, c.rdb, keys).Int64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return count, true, nil
+}
+
+// SetFollowingSetOversize write the marker
+func (c *relationSetCache) SetFollowingSetOversize(ctx context.Context, userID int64, duration time.Duration) error {
+	return c.rdb.Set(ctx, c.GetFollowingSetOversizeKey(userID), 1, c.cfg.Jitter(duration)).Err()
+}
+
+// SetFollowerSetOversize write the marker
+func (c *relationSetCache) SetFollowerSetOversize(ctx context.Context, userID int64, duration time.Duration) error {
+	return c.rdb.Set(ctx, c.GetFollowerSetOversizeKey(userID), 1, c.cfg.Jitter(duration)).Err()
+}
+
+// GetSetOversize check both markers in one round trip
+func (c *relationSetCache) GetSetOversize(ctx context.Context, followingUID, followerUID int64) (bool, bool, error) {
+	pipe := c.rdb.Pipeline()
+	followingCmd := pipe.Exists(ctx, c.GetFollowingSetOversizeKey(followingUID))
+	followerCmd := pipe.Exists(ctx, c.GetFollowerSetOversizeKey(followerUID))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, false, err
+	}
+	return followingCmd.Val() > 0, followerCmd.Val() > 0, nil
+}
+
+// setSet replace the whole set, an empty list is not cached as redis can not hold an empty set
+func (c *relationSetCache) setSet(ctx context.Context, key string, uids []int64, duration time.Duration) error {
+	if len(uids) == 0 {
+		return nil
+	}
+	members := make([]interface{}, 0, len(uids))
+	for _, uid := range uids {
+		members = append(members, uid)
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, c.cfg.Jitter(duration))
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,108 @@
+package cache
+
+//go:generate mockgen -source=internal/cache/user_close_friend_cache.go -destination=internal/mock/user_close_friend_cache_mock.go  -package mock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/cache"
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// PrefixUserCloseFriendCacheKey cache prefix
+	PrefixUserCloseFriendCacheKey = "user:close_friend:%d_%d"
+)
+
+// UserCloseFriendCache define cache interface
+type UserCloseFriendCache interface {
+	SetUserCloseFriendCache(ctx context.Context, userID, friendUID int64, data *model.UserCloseFriendModel, duration time.Duration) error
+	GetUserCloseFriendCache(ctx context.Context, userID, friendUID int64) (data *model.UserCloseFriendModel, err error)
+	MultiGetUserCloseFriendCache(ctx context.Context, userID int64, friendUIDs []int64) (map[int64]*model.UserCloseFriendModel, error)
+	DelUserCloseFriendCache(ctx context.Context, userID, friendUID int64) error
+}
+
+// userCloseFriendCache define cache struct
+type userCloseFriendCache struct {
+	cache cache.Cache
+	cfg   *Config
+}
+
+// NewUserCloseFriendCache new a cache
+func NewUserCloseFriendCache(rdb *redis.Client, cfg *Config) UserCloseFriendCache {
+	return &userCloseFriendCache{
+		cache: cache.NewRedisCache(rdb, cfg.Prefix, newEncoding(cfg.Encoding), func() interface{} {
+			return &model.UserCloseFriendModel{}
+		}),
+		cfg: cfg,
+	}
+}
+
+// GetUserCloseFriendCacheKey get cache key
+func (c *userCloseFriendCache) GetUserCloseFriendCacheKey(userID, friendUID int64) string {
+	return fmt.Sprintf(PrefixUserCloseFriendCacheKey, userID, friendUID)
+}
+
+// SetUserCloseFriendCache write to cache
+func (c *userCloseFriendCache) SetUserCloseFriendCache(ctx context.Context, userID, friendUID int64, data *model.UserCloseFriendModel, duration time.Duration) error {
+	if data == nil || userID == 0 {
+		return nil
+	}
+	cacheKey := c.GetUserCloseFriendCacheKey(userID, friendUID)
+	err := c.cache.Set(ctx, cacheKey, data, c.cfg.Jitter(duration))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetUserCloseFriendCache get from cache
+func (c *userCloseFriendCache) GetUserCloseFriendCache(ctx context.Context, userID, friendUID int64) (data *model.UserCloseFriendModel, err error) {
+	cacheKey := c.GetUserCloseFriendCacheKey(userID, friendUID)
+	err = c.cache.Get(ctx, cacheKey, &data)
+	if err != nil {
+		log.WithContext(ctx).Warnf("get err from redis, err: %+v", err)
+		return nil, err
+	}
+	return data, nil
+}
+
+// MultiGetUserCloseFriendCache batch get from cache, return friendUID -> data
+func (c *userCloseFriendCache) MultiGetUserCloseFriendCache(ctx context.Context, userID int64, friendUIDs []int64) (map[int64]*model.UserCloseFriendModel, error) {
+	keys := make([]string, 0, len(friendUIDs))
+	keyToUID := make(map[string]int64, len(friendUIDs))
+	for _, uid := range friendUIDs {
+		cacheKey := c.GetUserCloseFriendCacheKey(userID, uid)
+		keys = append(keys, cacheKey)
+		keyToUID[c.cfg.BuildKey(cacheKey)] = uid
+	}
+
+	cacheMap := make(map[string]*model.UserCloseFriendModel)
+	err := c.cache.MultiGet(ctx, keys, cacheMap)
+	if err != nil {
+		return nil, err
+	}
+
+	retMap := make(map[int64]*model.UserCloseFriendModel, len(cacheMap))
+	for k, v := range cacheMap {
+		if uid, ok := keyToUID[k]; ok {
+			retMap[uid] = v
+		}
+	}
+	return retMap, nil
+}
+
+// DelUserCloseFriendCache delete cache
+func (c *userCloseFriendCache) DelUserCloseFriendCache(ctx context.Context, userID, friendUID int64) error {
+	cacheKey := c.GetUserCloseFriendCacheKey(userID, friendUID)
+	err := c.cache.Del(ctx, cacheKey)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/go-eagle/eagle/pkg/cache"
-	"github.com/go-eagle/eagle/pkg/encoding"
 	"github.com/go-eagle/eagle/pkg/log"
 	"github.com/redis/go-redis/v9"
 
@@ -25,21 +24,22 @@ type UserFollowerCache interface {
 	SetUserFollowerCache(ctx context.Context, userID, followedUID int64, data *model.UserFollowerModel, duration time.Duration) error
 	GetUserFollowerCache(ctx context.Context, userID, followedUID int64) (data *model.UserFollowerModel, err error)
 	DelUserFollowerCache(ctx context.Context, userID, followedUID int64) error
+	MultiSetUserFollowerCache(ctx context.Context, userID int64, data []*model.UserFollowerModel, duration time.Duration) error
 }
 
 // userFollowerCache define cache struct
 type userFollowerCache struct {
 	cache cache.Cache
+	cfg   *Config
 }
 
 // NewUserFollowerCache new a cache
-func NewUserFollowerCache(rdb *redis.Client) UserFollowerCache {
-	jsonEncoding := encoding.JSONEncoding{}
-	cachePrefix := ""
+func NewUserFollowerCache(rdb *redis.Client, cfg *Config) UserFollowerCache {
 	return &userFollowerCache{
-		cache: cache.NewRedisCache(rdb, cachePrefix, jsonEncoding, func() interface{} {
+		cache: cache.NewRedisCache(rdb, cfg.Prefix, newEncoding(cfg.Encoding), func() interface{} {
 			return &model.UserFollowerModel{}
 		}),
+		cfg: cfg,
 	}
 }
 
@@ -54,7 +54,7 @@ func (c *userFollowerCache) SetUserFollowerCache(ctx context.Context, userID, fo
 		return nil
 	}
 	cacheKey := c.GetUserFollowerCacheKey(userID, followedUID)
-	err := c.cache.Set(ctx, cacheKey, data, duration)
+	err := c.cache.Set(ctx, cacheKey, data, c.cfg.Jitter(duration))
 	if err != nil {
 		return err
 	}
@@ -72,6 +72,18 @@ func (c *userFollowerCache) GetUserFollowerCache(ctx context.Context, userID, fo
 	return data, nil
 }
 
+// MultiSetUserFollowerCache batch write to cache
+func (c *userFollowerCache) MultiSetUserFollowerCache(ctx context.Context, userID int64, data []*model.UserFollowerModel, duration time.Duration) error {
+	if len(data) == 0 || userID == 0 {
+		return nil
+	}
+	valMap := make(map[string]interface{}, len(data))
+	for _, v := range data {
+		valMap[c.GetUserFollowerCacheKey(userID, v.FollowerUID)] = v
+	}
+	return c.cache.MultiSet(ctx, valMap, c.cfg.Jitter(duration))
+}
+
 // DelUserFollowerCache delete cache
 func (c *userFollowerCache) DelUserFollowerCache(ctx context.Context, userID, followedUID int64) error {
 	cacheKey := c.GetUserFollowerCacheKey(userID, followedUID)
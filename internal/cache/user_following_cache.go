@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/go-eagle/eagle/pkg/cache"
-	"github.com/go-eagle/eagle/pkg/encoding"
 	"github.com/go-eagle/eagle/pkg/log"
 	"github.com/redis/go-redis/v9"
 
@@ -25,21 +24,23 @@ type UserFollowingCache interface {
 	SetUserFollowingCache(ctx context.Context, userID, followedUID int64, data *model.UserFollowingModel, duration time.Duration) error
 	GetUserFollowingCache(ctx context.Context, userID, followedUID int64) (data *model.UserFollowingModel, err error)
 	DelUserFollowingCache(ctx context.Context, userID, followedUID int64) error
+	MultiGetUserFollowingCache(ctx context.Context, userID int64, followedUIDs []int64) (map[int64]*model.UserFollowingModel, error)
+	MultiSetUserFollowingCache(ctx context.Context, userID int64, data []*model.UserFollowingModel, duration time.Duration) error
 }
 
 // userFollowingCache define cache struct
 type userFollowingCache struct {
 	cache cache.Cache
+	cfg   *Config
 }
 
 // NewUserFollowingCache new a cache
-func NewUserFollowingCache(rdb *redis.Client) UserFollowingCache {
-	jsonEncoding := encoding.JSONEncoding{}
-	cachePrefix := ""
+func NewUserFollowingCache(rdb *redis.Client, cfg *Config) UserFollowingCache {
 	return &userFollowingCache{
-		cache: cache.NewRedisCache(rdb, cachePrefix, jsonEncoding, func() interface{} {
+		cache: cache.NewRedisCache(rdb, cfg.Prefix, newEncoding(cfg.Encoding), func() interface{} {
 			return &model.UserFollowingModel{}
 		}),
+		cfg: cfg,
 	}
 }
 
@@ -54,7 +55,7 @@ func (c *userFollowingCache) SetUserFollowingCache(ctx context.Context, userID,
 		return nil
 	}
 	cacheKey := c.GetUserFollowingCacheKey(userID, followedUID)
-	err := c.cache.Set(ctx, cacheKey, data, duration)
+	err := c.cache.Set(ctx, cacheKey, data, c.cfg.Jitter(duration))
 	if err != nil {
 		return err
 	}
@@ -72,6 +73,44 @@ func (c *userFollowingCache) GetUserFollowingCache(ctx context.Context, userID,
 	return data, nil
 }
 
+// MultiGetUserFollowingCache batch get from cache, return followedUID -> data
+func (c *userFollowingCache) MultiGetUserFollowingCache(ctx context.Context, userID int64, followedUIDs []int64) (map[int64]*model.UserFollowingModel, error) {
+	keys := make([]string, 0, len(followedUIDs))
+	keyToUID := make(map[string]int64, len(followedUIDs))
+	for _, uid := range followedUIDs {
+		cacheKey := c.GetUserFollowingCacheKey(userID, uid)
+		keys = append(keys, cacheKey)
+		keyToUID[c.cfg.BuildKey(cacheKey)] = uid
+	}
+
+	// NOTE: the key of cacheMap is the full cache key
+	cacheMap := make(map[string]*model.UserFollowingModel)
+	err := c.cache.MultiGet(ctx, keys, cacheMap)
+	if err != nil {
+		return nil, err
+	}
+
+	retMap := make(map[int64]*model.UserFollowingModel, len(cacheMap))
+	for k, v := range cacheMap {
+		if uid, ok := keyToUID[k]; ok {
+			retMap[uid] = v
+		}
+	}
+	return retMap, nil
+}
+
+// MultiSetUserFollowingCache batch write to cache
+func (c *userFollowingCache) MultiSetUserFollowingCache(ctx context.Context, userID int64, data []*model.UserFollowingModel, duration time.Duration) error {
+	if len(data) == 0 || userID == 0 {
+		return nil
+	}
+	valMap := make(map[string]interface{}, len(data))
+	for _, v := range data {
+		valMap[c.GetUserFollowingCacheKey(userID, v.FollowedUID)] = v
+	}
+	return c.cache.MultiSet(ctx, valMap, c.cfg.Jitter(duration))
+}
+
 // DelUserFollowingCache delete cache
 func (c *userFollowingCache) DelUserFollowingCache(ctx context.Context, userID, followedUID int64) error {
 	cacheKey := c.GetUserFollowingCacheKey(userID, followedUID)
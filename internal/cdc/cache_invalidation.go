@@ -0,0 +1,70 @@
+package cdc
+
+import (
+	"context"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_tableUserFollowing   = (&model.UserFollowingModel{}).TableName()
+	_tableUserFollower    = (&model.UserFollowerModel{}).TableName()
+	_tableUserCloseFriend = (&model.UserCloseFriendModel{}).TableName()
+)
+
+// CacheInvalidation delete the caches of the changed rows,
+// it covers the writes which do not go through the repos, eg: scripts, manual fixes.
+type CacheInvalidation struct {
+	followingCache   cache.UserFollowingCache
+	followerCache    cache.UserFollowerCache
+	setCache         cache.RelationSetCache
+	closeFriendCache cache.UserCloseFriendCache
+}
+
+// NewCacheInvalidation new a cache invalidation handler
+func NewCacheInvalidation(followingCache cache.UserFollowingCache, followerCache cache.UserFollowerCache,
+	setCache cache.RelationSetCache, closeFriendCache cache.UserCloseFriendCache) *CacheInvalidation {
+	return &CacheInvalidation{
+		followingCache:   followingCache,
+		followerCache:    followerCache,
+		setCache:         setCache,
+		closeFriendCache: closeFriendCache,
+	}
+}
+
+// Handle implements Handler
+func (c *CacheInvalidation) Handle(ctx context.Context, event *ChangeEvent) error {
+	userID, ok := event.Int64("user_id")
+	if !ok {
+		return nil
+	}
+
+	switch event.Table {
+	case _tableUserFollowing:
+		followedUID, ok := event.Int64("followed_uid")
+		if !ok {
+			return nil
+		}
+		if err := c.followingCache.DelUserFollowingCache(ctx, userID, followedUID); err != nil {
+			return err
+		}
+		return c.setCache.DelFollowingSetCache(ctx, userID)
+	case _tableUserFollower:
+		followerUID, ok := event.Int64("follower_uid")
+		if !ok {
+			return nil
+		}
+		if err := c.followerCache.DelUserFollowerCache(ctx, userID, followerUID); err != nil {
+			return err
+		}
+		return c.setCache.DelFollowerSetCache(ctx, userID)
+	case _tableUserCloseFriend:
+		friendUID, ok := event.Int64("friend_uid")
+		if !ok {
+			return nil
+		}
+		return c.closeFriendCache.DelUserCloseFriendCache(ctx, userID, friendUID)
+	}
+	return nil
+}
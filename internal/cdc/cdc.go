@@ -0,0 +1,67 @@
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const (
+	// EventInsert row inserted
+	EventInsert = "insert"
+	// EventUpdate row updated
+	EventUpdate = "update"
+	// EventDelete row deleted
+	EventDelete = "delete"
+)
+
+// Config cdc config, see config/{env}/consumer.yaml
+type Config struct {
+	Enable bool
+	// Stream redis stream 的名称, 由 maxwell 等 binlog 同步工具写入
+	Stream string
+	// Group 消费组, 多个实例共用一个消费组
+	Group string
+	// Consumer 消费者名称, 为空时使用 hostname
+	Consumer string
+	// Field 消息中存放 json 的字段名
+	Field     string
+	BatchSize int64
+	Block     time.Duration
+}
+
+// ChangeEvent a row change of the binlog, the format is compatible with maxwell
+type ChangeEvent struct {
+	Database string                 `json:"database"`
+	Table    string                 `json:"table"`
+	Type     string                 `json:"type"`
+	Data     map[string]interface{} `json:"data"`
+	// Old the previous values of the changed columns, only for update
+	Old map[string]interface{} `json:"old"`
+}
+
+// Int64 get an integer column of the row
+func (e *ChangeEvent) Int64(column string) (int64, bool) {
+	switch v := e.Data[column].(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// Handler handle a change event, the event will be redelivered if an error is returned
+type Handler func(ctx context.Context, event *ChangeEvent) error
+
+// Source the source of change events, eg: redis stream, kafka
+type Source interface {
+	// Run block and deliver the events to handler until ctx is done
+	Run(ctx context.Context, handler Handler) error
+}
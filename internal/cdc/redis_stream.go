@@ -0,0 +1,118 @@
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultStreamField     = "message"
+	defaultStreamBatchSize = 100
+	defaultStreamBlock     = 5 * time.Second
+)
+
+var _ Source = (*redisStreamSource)(nil)
+
+// redisStreamSource read events from a redis stream with consumer group,
+// eg: maxwell with producer=redis and redis_type=xadd
+type redisStreamSource struct {
+	rdb *redis.Client
+	cfg Config
+}
+
+// NewRedisStreamSource new a redis stream source
+func NewRedisStreamSource(rdb *redis.Client, cfg Config) Source {
+	if cfg.Consumer == "" {
+		cfg.Consumer, _ = os.Hostname()
+	}
+	if cfg.Field == "" {
+		cfg.Field = defaultStreamField
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultStreamBatchSize
+	}
+	if cfg.Block <= 0 {
+		cfg.Block = defaultStreamBlock
+	}
+	return &redisStreamSource{
+		rdb: rdb,
+		cfg: cfg,
+	}
+}
+
+// Run consume the stream
+func (s *redisStreamSource) Run(ctx context.Context, handler Handler) error {
+	err := s.rdb.XGroupCreateMkStream(ctx, s.cfg.Stream, s.cfg.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group err: %v", err)
+	}
+
+	// the pending messages of last run are handled first
+	id := "0"
+	for ctx.Err() == nil {
+		streams, err := s.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.cfg.Group,
+			Consumer: s.cfg.Consumer,
+			Streams:  []string{s.cfg.Stream, id},
+			Count:    s.cfg.BatchSize,
+			Block:    s.cfg.Block,
+		}).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			log.Warnf("read stream %s err: %v", s.cfg.Stream, err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		var messages []redis.XMessage
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+		if id == "0" && len(messages) == 0 {
+			id = ">"
+			continue
+		}
+		for _, msg := range messages {
+			if err := s.handle(ctx, msg, handler); err != nil {
+				// not acked, it is redelivered on next start
+				log.Warnf("handle message %s err: %v", msg.ID, err)
+				continue
+			}
+			_ = s.rdb.XAck(ctx, s.cfg.Stream, s.cfg.Group, msg.ID).Err()
+		}
+		if id != ">" {
+			id = messages[len(messages)-1].ID
+		}
+	}
+	return nil
+}
+
+func (s *redisStreamSource) handle(ctx context.Context, msg redis.XMessage, handler Handler) error {
+	raw, ok := msg.Values[s.cfg.Field].(string)
+	if !ok {
+		// can not be handled anyway, skip it
+		log.Warnf("field %s not found in message %s", s.cfg.Field, msg.ID)
+		return nil
+	}
+	var event ChangeEvent
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&event); err != nil {
+		log.Warnf("decode message %s err: %v", msg.ID, err)
+		return nil
+	}
+	return handler(ctx, &event)
+}
@@ -0,0 +1,41 @@
+package ecode
+
+import (
+	"github.com/go-eagle/eagle/pkg/errcode"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error an error code shared by the requests.
+// errcode.GrpcStatus keeps the details in itself, so a fresh one is built on each call
+// instead of setting the details on a shared status concurrently.
+type Error struct {
+	code codes.Code
+	msg  string
+}
+
+// New new an error code
+func New(code codes.Code, msg string) *Error {
+	return &Error{code: code, msg: msg}
+}
+
+// Code the grpc code
+func (e *Error) Code() codes.Code {
+	return e.code
+}
+
+// Message the message returned to the client
+func (e *Error) Message() string {
+	return e.msg
+}
+
+// WithDetails new a status with the details
+func (e *Error) WithDetails(details ...proto.Message) *errcode.GrpcStatus {
+	return errcode.New(e.code, e.msg).WithDetails(details...)
+}
+
+// Status new a grpc status with the details
+func (e *Error) Status(details ...proto.Message) *status.Status {
+	return e.WithDetails().Status(details...)
+}
@@ -1,18 +1,26 @@
 package ecode
 
 import (
-	"github.com/go-eagle/eagle/pkg/errcode"
 	"google.golang.org/grpc/codes"
 )
 
 //nolint: golint
 var (
 	// common errors
-	ErrInvalidArgument = errcode.New(codes.InvalidArgument, "Invalid argument")
-	ErrInternalError   = errcode.New(codes.Internal, "Internal error")
-	ErrAccessDenied    = errcode.New(codes.PermissionDenied, "Access denied")
-	ErrNotFound        = errcode.New(codes.NotFound, "Not found")
+	ErrInvalidArgument = New(codes.InvalidArgument, "Invalid argument")
+	ErrInternalError   = New(codes.Internal, "Internal error")
+	ErrAccessDenied    = New(codes.PermissionDenied, "Access denied")
+	ErrNotFound        = New(codes.NotFound, "Not found")
 
-	// relation grpc errors
-	ErrUserIsExist = errcode.New(20100, "The user already exists.")
+	// relation grpc errors, see the codes in api/relation/v1/relation.proto
+	ErrUserIsExist           = New(20100, "The user already exists.")
+	ErrRelationGroupExceeded = New(20101, "The number of groups exceeds the limit.")
+	ErrFollowLimitExceeded   = New(20102, "The number of followings exceeds the limit.")
+	ErrFollowSelf            = New(20103, "Can not follow or unfollow yourself.")
+	ErrTargetNotFound        = New(20104, "The target user does not exist.")
+	ErrBlocked               = New(20105, "The relation is blocked.")
+	ErrRateLimited           = New(20106, "Too many requests, please try again later.")
+	ErrNotFollowed           = New(20107, "The user is not followed.")
+	ErrTargetUnavailable     = New(20108, "The target user is banned or deactivated.")
+	ErrNotFollower           = New(20109, "The user is not a follower.")
 )
@@ -0,0 +1,91 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/hibiken/asynq"
+)
+
+// TaskTypeRelationEvent the asynq task type of the events
+const TaskTypeRelationEvent = "relation:event"
+
+var _ Broker = (*asynqBroker)(nil)
+
+// asynqBroker deliver the events by asynq, the events which fail after MaxRetry are archived by asynq
+type asynqBroker struct {
+	cfg    *Config
+	opt    asynq.RedisClientOpt
+	client *asynq.Client
+}
+
+// NewAsynqBroker new an asynq broker on the default redis of redis.yaml
+func NewAsynqBroker(cfg *Config) (Broker, error) {
+	c, err := redis.LoadConf(redis.DefaultRedisName)
+	if err != nil {
+		return nil, fmt.Errorf("load redis conf err: %v", err)
+	}
+	opt := asynq.RedisClientOpt{
+		Addr:         c.Addr,
+		Password:     c.Password,
+		DB:           c.DB,
+		DialTimeout:  c.DialTimeout,
+		ReadTimeout:  c.ReadTimeout,
+		WriteTimeout: c.WriteTimeout,
+		PoolSize:     c.PoolSize,
+	}
+	return &asynqBroker{
+		cfg:    cfg,
+		opt:    opt,
+		client: asynq.NewClient(opt),
+	}, nil
+}
+
+// Publish enqueue the event, the event id is used as the task id so that it is enqueued once
+func (b *asynqBroker) Publish(ctx context.Context, ev *Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	_, err = b.client.EnqueueContext(ctx, asynq.NewTask(TaskTypeRelationEvent, payload),
+		asynq.Queue(b.cfg.Queue), asynq.MaxRetry(b.cfg.MaxRetry), asynq.TaskID(ev.ID))
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+// Consume run an asynq server on the event queue, the in-flight events are waited for
+// at most ShutdownTimeout when ctx is done
+func (b *asynqBroker) Consume(ctx context.Context, handler Handler) error {
+	srv := asynq.NewServer(b.opt, asynq.Config{
+		Concurrency:     b.cfg.Concurrency,
+		Queues:          map[string]int{b.cfg.Queue: 1},
+		ShutdownTimeout: b.cfg.ShutdownTimeout,
+		Logger:          log.GetLogger(),
+	})
+
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(TaskTypeRelationEvent, func(ctx context.Context, t *asynq.Task) error {
+		var ev Event
+		if err := json.Unmarshal(t.Payload(), &ev); err != nil {
+			return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+		}
+		return handler(ctx, &ev)
+	})
+	if err := srv.Start(mux); err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	srv.Shutdown()
+	return nil
+}
+
+func (b *asynqBroker) Close() error {
+	return b.client.Close()
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"context"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet is event providers.
+var ProviderSet = wire.NewSet(LoadConf, NewPublisher)
+
+// Publisher publish the events
+type Publisher interface {
+	Publish(ctx context.Context, ev *Event) error
+}
+
+// Broker deliver the published events to the consumers, eg: asynq, kafka
+type Broker interface {
+	Publisher
+	// Consume block and deliver the events to handler until ctx is done,
+	// the event is redelivered later if handler returns an error.
+	Consume(ctx context.Context, handler Handler) error
+	Close() error
+}
+
+// NewPublisher new the publisher of the server, the events are dropped if it is disabled
+func NewPublisher(cfg *Config) (Publisher, func(), error) {
+	if !cfg.Enable {
+		return nopPublisher{}, func() {}, nil
+	}
+	broker, err := NewAsynqBroker(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return broker, func() { _ = broker.Close() }, nil
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(ctx context.Context, ev *Event) error {
+	return nil
+}
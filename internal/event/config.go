@@ -0,0 +1,98 @@
+package event
+
+import (
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/config"
+)
+
+const (
+	defaultQueue           = "relation_event"
+	defaultConcurrency     = 10
+	defaultMaxRetry        = 5
+	defaultShutdownTimeout = 10 * time.Second
+	defaultDeadLetterKey   = "relation:event:dead_letter"
+	defaultDeadLetterLen   = 100000
+	defaultHandledPrefix   = "relation:event:handled"
+	defaultHandledTTL      = 24 * time.Hour
+)
+
+// Config relation event config, see config/{env}/event.yaml,
+// it is shared by the server which publishes the events and the consumer
+type Config struct {
+	// Enable 是否发布关系事件, 关闭时 consumer 也不消费
+	Enable bool
+	// Queue asynq 队列名
+	Queue string
+	// Concurrency 同时处理的事件数
+	Concurrency int
+	// MaxRetry 事件分发失败(如写入死信队列失败)后的重试次数, 超过后由 asynq 归档,
+	// 重试时只调用还没有处理成功的处理器
+	MaxRetry int
+	// ShutdownTimeout 退出时等待处理中的事件的最长时间
+	ShutdownTimeout time.Duration
+	// DeadLetter 重试用尽的事件
+	DeadLetter DeadLetterConfig
+	// Handled 已经处理成功的处理器
+	Handled HandledConfig
+	// Handlers 各个处理器的重试策略, key 为处理器名称, 未配置的使用注册时的默认值
+	Handlers map[string]RetryPolicy
+}
+
+// DeadLetterConfig dead letter queue config
+type DeadLetterConfig struct {
+	// Key 死信队列的 redis list
+	Key string
+	// MaxLen 最多保留的死信数, 超过后丢弃最早的
+	MaxLen int64
+}
+
+// HandledConfig handled store config
+type HandledConfig struct {
+	// KeyPrefix 每个事件一个 redis set, key 为 {KeyPrefix}:{event id}
+	KeyPrefix string
+	// TTL 需要大于事件重试的时间
+	TTL time.Duration
+}
+
+// LoadConf load event config
+func LoadConf() (*Config, error) {
+	v, err := config.LoadWithType("event", "yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := v.Unmarshal(&c); err != nil {
+		return nil, err
+	}
+	c.setDefaults()
+	return &c, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.Queue == "" {
+		c.Queue = defaultQueue
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = defaultConcurrency
+	}
+	if c.MaxRetry <= 0 {
+		c.MaxRetry = defaultMaxRetry
+	}
+	if c.ShutdownTimeout <= 0 {
+		c.ShutdownTimeout = defaultShutdownTimeout
+	}
+	if c.DeadLetter.Key == "" {
+		c.DeadLetter.Key = defaultDeadLetterKey
+	}
+	if c.DeadLetter.MaxLen <= 0 {
+		c.DeadLetter.MaxLen = defaultDeadLetterLen
+	}
+	if c.Handled.KeyPrefix == "" {
+		c.Handled.KeyPrefix = defaultHandledPrefix
+	}
+	if c.Handled.TTL <= 0 {
+		c.Handled.TTL = defaultHandledTTL
+	}
+}
@@ -0,0 +1,48 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DeadLetter an event which a handler failed to handle
+type DeadLetter struct {
+	Handler  string    `json:"handler"`
+	Event    *Event    `json:"event"`
+	Error    string    `json:"error"`
+	Attempts int       `json:"attempts"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// DeadLetterQueue save the dead letters for inspection and replay
+type DeadLetterQueue interface {
+	Push(ctx context.Context, dl *DeadLetter) error
+}
+
+var _ DeadLetterQueue = (*redisDeadLetterQueue)(nil)
+
+// redisDeadLetterQueue a capped redis list, the newest is at the head
+type redisDeadLetterQueue struct {
+	rdb *redis.Client
+	cfg DeadLetterConfig
+}
+
+// NewRedisDeadLetterQueue new a dead letter queue on redis
+func NewRedisDeadLetterQueue(rdb *redis.Client, cfg *Config) DeadLetterQueue {
+	return &redisDeadLetterQueue{rdb: rdb, cfg: cfg.DeadLetter}
+}
+
+func (q *redisDeadLetterQueue) Push(ctx context.Context, dl *DeadLetter) error {
+	b, err := json.Marshal(dl)
+	if err != nil {
+		return err
+	}
+	pipe := q.rdb.TxPipeline()
+	pipe.LPush(ctx, q.cfg.Key, b)
+	pipe.LTrim(ctx, q.cfg.Key, 0, q.cfg.MaxLen-1)
+	_, err = pipe.Exec(ctx)
+	return err
+}
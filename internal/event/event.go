@@ -0,0 +1,44 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// relation event types
+const (
+	// TypeFollow user_id followed target_uid
+	TypeFollow = "relation.follow"
+	// TypeUnfollow user_id unfollowed target_uid
+	TypeUnfollow = "relation.unfollow"
+)
+
+// Event a relation change, it is published after the transaction is committed
+type Event struct {
+	// ID 事件的唯一 id, 用于去重
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	UserID    int64  `json:"user_id"`
+	TargetUID int64  `json:"target_uid"`
+	// Source 关注来源, 只有关注事件有
+	Source int32 `json:"source,omitempty"`
+	// Batch 由注销、封禁、合并等批量任务产生, 不通知被关注的用户
+	Batch      bool      `json:"batch,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewEvent new an event which occurred now
+func NewEvent(typ string, userID, targetUID int64) *Event {
+	return &Event{
+		ID:         uuid.New().String(),
+		Type:       typ,
+		UserID:     userID,
+		TargetUID:  targetUID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// Handler handle an event, it should be idempotent because an event may be delivered more than once
+type Handler func(ctx context.Context, ev *Event) error
@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// HandledStore record the handlers which have handled an event, so that only the others are called
+// when the event is redelivered
+type HandledStore interface {
+	// Handled get the names of the handlers which have handled the event
+	Handled(ctx context.Context, eventID string) (map[string]bool, error)
+	MarkHandled(ctx context.Context, eventID, handler string) error
+}
+
+var _ HandledStore = (*redisHandledStore)(nil)
+
+// redisHandledStore a redis set of the handler names per event, it expires after the redeliveries
+type redisHandledStore struct {
+	rdb *redis.Client
+	cfg HandledConfig
+}
+
+// NewRedisHandledStore new a handled store on redis
+func NewRedisHandledStore(rdb *redis.Client, cfg *Config) HandledStore {
+	return &redisHandledStore{rdb: rdb, cfg: cfg.Handled}
+}
+
+func (s *redisHandledStore) key(eventID string) string {
+	return fmt.Sprintf("%s:%s", s.cfg.KeyPrefix, eventID)
+}
+
+func (s *redisHandledStore) Handled(ctx context.Context, eventID string) (map[string]bool, error) {
+	names, err := s.rdb.SMembers(ctx, s.key(eventID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	handled := make(map[string]bool, len(names))
+	for _, name := range names {
+		handled[name] = true
+	}
+	return handled, nil
+}
+
+func (s *redisHandledStore) MarkHandled(ctx context.Context, eventID, handler string) error {
+	key := s.key(eventID)
+	pipe := s.rdb.TxPipeline()
+	pipe.SAdd(ctx, key, handler)
+	pipe.Expire(ctx, key, s.cfg.TTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
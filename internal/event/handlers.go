@@ -0,0 +1,103 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+// handler names
+const (
+	HandlerCounter      = "counter"
+	HandlerCache        = "cache"
+	HandlerNotification = "notification"
+)
+
+// NewCounterHandler add the change to the relation snapshots of the day, which hold the follower and following counts.
+// The snapshot of a user is counted from the tables only by the first event of the day, which is missing then.
+// An event counted twice by a retry or by the first count makes the counts drift, the snapshot task rebuilds
+// the day after it ends, so the drift lasts one day at most.
+func NewCounterHandler(snapshotRepo repository.UserRelationSnapshotRepo) Handler {
+	return func(ctx context.Context, ev *Event) error {
+		following := &model.UserRelationSnapshotModel{FollowingCount: 1}
+		follower := &model.UserRelationSnapshotModel{FollowerCount: 1, FollowerGain: 1}
+		if ev.Type == TypeUnfollow {
+			following = &model.UserRelationSnapshotModel{FollowingCount: -1}
+			follower = &model.UserRelationSnapshotModel{FollowerCount: -1, FollowerLoss: 1}
+		}
+
+		missing := make([]int64, 0, 2)
+		ok, err := snapshotRepo.IncrUserRelationSnapshot(ctx, ev.OccurredAt, ev.UserID, following)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			missing = append(missing, ev.UserID)
+		}
+		ok, err = snapshotRepo.IncrUserRelationSnapshot(ctx, ev.OccurredAt, ev.TargetUID, follower)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			missing = append(missing, ev.TargetUID)
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		_, err = snapshotRepo.RefreshUserRelationSnapshot(ctx, ev.OccurredAt, missing)
+		return err
+	}
+}
+
+// NewCacheRefreshHandler load the changed edge into the caches, so that the next read does not go to DB.
+// It waits until the delayed double delete of the write is done, otherwise the loaded cache is deleted again.
+func NewCacheRefreshHandler(followingRepo repository.UserFollowingRepo, followerRepo repository.UserFollowerRepo,
+	invalidator *repository.CacheInvalidator) Handler {
+	return func(ctx context.Context, ev *Event) error {
+		if wait := time.Until(ev.OccurredAt.Add(invalidator.Delay())); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		if _, err := followingRepo.GetUserFollowing(ctx, ev.UserID, ev.TargetUID); err != nil {
+			return err
+		}
+		_, err := followerRepo.GetUserFollower(ctx, ev.TargetUID, ev.UserID)
+		return err
+	}
+}
+
+// Notifier notify the followed user
+type Notifier interface {
+	NotifyFollow(ctx context.Context, ev *Event) error
+}
+
+// NewNotificationHandler notify the followed user of a new follower, the follows transferred by a merge are skipped
+func NewNotificationHandler(notifier Notifier) Handler {
+	return func(ctx context.Context, ev *Event) error {
+		if ev.Type != TypeFollow || ev.Batch {
+			return nil
+		}
+		return notifier.NotifyFollow(ctx, ev)
+	}
+}
+
+// logNotifier only logs the notifications, it is used until a notification service is available
+type logNotifier struct{}
+
+// NewLogNotifier new a notifier which writes logs
+func NewLogNotifier() Notifier {
+	return logNotifier{}
+}
+
+func (logNotifier) NotifyFollow(ctx context.Context, ev *Event) error {
+	log.WithContext(ctx).Infof("[event] notify user %d of new follower %d", ev.TargetUID, ev.UserID)
+	return nil
+}
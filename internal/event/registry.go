@@ -0,0 +1,168 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/go-eagle/eagle/pkg/metric"
+)
+
+var (
+	handledCounter = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "relation",
+		Subsystem: "event",
+		Name:      "handled_total",
+		Help:      "relation event handled count by handler and result.",
+		Labels:    []string{"type", "handler", "result"},
+	})
+)
+
+// RetryPolicy the retry policy of a handler, the attempts are retried in process
+// with exponential backoff, the event goes to the dead letter queue after MaxAttempts.
+type RetryPolicy struct {
+	// MaxAttempts 最多执行次数, 包含第一次
+	MaxAttempts int
+	// Backoff 第一次重试前的等待时间, 之后每次翻倍
+	Backoff time.Duration
+	// MaxBackoff 重试等待时间的上限
+	MaxBackoff time.Duration
+	// Timeout 每次执行的超时时间, 为 0 时不限制
+	Timeout time.Duration
+}
+
+// backoff the wait before the attempt, attempt starts from 1
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt-1 && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+type registration struct {
+	name    string
+	handler Handler
+	policy  RetryPolicy
+}
+
+// Registry map the event types to the handlers, every handler of the type is called for an event
+type Registry struct {
+	handlers   map[string][]*registration
+	policies   map[string]RetryPolicy
+	deadLetter DeadLetterQueue
+	handled    HandledStore
+}
+
+// NewRegistry new a registry, the policies in cfg override the defaults of Register
+func NewRegistry(cfg *Config, deadLetter DeadLetterQueue, handled HandledStore) *Registry {
+	return &Registry{
+		handlers:   make(map[string][]*registration),
+		policies:   cfg.Handlers,
+		deadLetter: deadLetter,
+		handled:    handled,
+	}
+}
+
+// Register add a handler of the event types, name identifies the handler in metrics and dead letters
+func (r *Registry) Register(name string, handler Handler, policy RetryPolicy, types ...string) {
+	if p, ok := r.policies[name]; ok {
+		policy = p
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 1
+	}
+	for _, typ := range types {
+		r.handlers[typ] = append(r.handlers[typ], &registration{name: name, handler: handler, policy: policy})
+	}
+}
+
+// Dispatch implements Handler, it calls the handlers one by one. A handler which fails after its retries
+// does not block the others, the event is pushed to the dead letter queue with the handler name.
+// An error is returned only if the dead letter can not be saved, then the event is redelivered by the broker,
+// and the handlers which have handled or dead-lettered it are skipped.
+func (r *Registry) Dispatch(ctx context.Context, ev *Event) error {
+	regs, ok := r.handlers[ev.Type]
+	if !ok {
+		log.WithContext(ctx).Warnf("[event] no handler for event type: %s, id: %s", ev.Type, ev.ID)
+		return nil
+	}
+
+	handled, err := r.handled.Handled(ctx, ev.ID)
+	if err != nil {
+		return err
+	}
+	for _, reg := range regs {
+		if handled[reg.name] {
+			handledCounter.Inc(ev.Type, reg.name, "skip")
+			continue
+		}
+		attempts, err := reg.run(ctx, ev)
+		if err == nil {
+			handledCounter.Inc(ev.Type, reg.name, "success")
+			r.markHandled(ctx, ev, reg.name)
+			continue
+		}
+		if ctx.Err() != nil {
+			// shutting down, the event is redelivered
+			return ctx.Err()
+		}
+
+		handledCounter.Inc(ev.Type, reg.name, "dead_letter")
+		log.WithContext(ctx).Errorf("[event] handler %s failed after %d attempts, event: %s, err: %v",
+			reg.name, attempts, ev.ID, err)
+		dl := &DeadLetter{
+			Handler:  reg.name,
+			Event:    ev,
+			Error:    err.Error(),
+			Attempts: attempts,
+			FailedAt: time.Now(),
+		}
+		if err := r.deadLetter.Push(ctx, dl); err != nil {
+			return err
+		}
+		r.markHandled(ctx, ev, reg.name)
+	}
+	return nil
+}
+
+// markHandled the handler is called again on redelivery if the mark fails, so the handlers are idempotent
+func (r *Registry) markHandled(ctx context.Context, ev *Event, name string) {
+	if err := r.handled.MarkHandled(ctx, ev.ID, name); err != nil {
+		log.WithContext(ctx).Warnf("[event] mark event %s handled by %s err: %v", ev.ID, name, err)
+	}
+}
+
+// run call the handler with its retry policy
+func (reg *registration) run(ctx context.Context, ev *Event) (int, error) {
+	var err error
+	for attempt := 1; attempt <= reg.policy.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			handledCounter.Inc(ev.Type, reg.name, "retry")
+			select {
+			case <-ctx.Done():
+				return attempt - 1, err
+			case <-time.After(reg.policy.backoff(attempt)):
+			}
+		}
+
+		err = reg.call(ctx, ev)
+		if err == nil {
+			return attempt, nil
+		}
+		log.WithContext(ctx).Warnf("[event] handler %s attempt %d err: %v", reg.name, attempt, err)
+	}
+	return reg.policy.MaxAttempts, err
+}
+
+func (reg *registration) call(ctx context.Context, ev *Event) error {
+	if reg.policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, reg.policy.Timeout)
+		defer cancel()
+	}
+	return reg.handler(ctx, ev)
+}
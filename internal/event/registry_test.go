@@ -0,0 +1,126 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	logger "github.com/go-eagle/eagle/pkg/log"
+)
+
+func TestMain(m *testing.M) {
+	config.New("../../config", config.WithEnv("dev"))
+	logger.Init()
+	os.Exit(m.Run())
+}
+
+var errPush = errors.New("push failed")
+
+type fakeDeadLetterQueue struct {
+	// fail the pushes fail
+	fail     bool
+	handlers []string
+}
+
+func (q *fakeDeadLetterQueue) Push(ctx context.Context, dl *DeadLetter) error {
+	if q.fail {
+		return errPush
+	}
+	q.handlers = append(q.handlers, dl.Handler)
+	return nil
+}
+
+type fakeHandledStore map[string]map[string]bool
+
+func (s fakeHandledStore) Handled(ctx context.Context, eventID string) (map[string]bool, error) {
+	return s[eventID], nil
+}
+
+func (s fakeHandledStore) MarkHandled(ctx context.Context, eventID, handler string) error {
+	if s[eventID] == nil {
+		s[eventID] = make(map[string]bool)
+	}
+	s[eventID][handler] = true
+	return nil
+}
+
+func TestRegistryDispatch(t *testing.T) {
+	tests := []struct {
+		name string
+		// failing the handlers always fail
+		failing []string
+		// deliveries the dead letter push fails in the deliveries except the last one
+		deliveries     int
+		wantCalls      map[string]int
+		wantDeadLetter []string
+	}{
+		{
+			name:       "all handled",
+			deliveries: 1,
+			wantCalls:  map[string]int{"a": 1, "b": 1, "c": 1},
+		},
+		{
+			name:           "failed handler is dead-lettered",
+			failing:        []string{"b"},
+			deliveries:     1,
+			wantCalls:      map[string]int{"a": 1, "b": 2, "c": 1},
+			wantDeadLetter: []string{"b"},
+		},
+		{
+			name:           "only the unhandled are called on redelivery",
+			failing:        []string{"b"},
+			deliveries:     3,
+			wantCalls:      map[string]int{"a": 1, "b": 6, "c": 1},
+			wantDeadLetter: []string{"b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := make(map[string]int)
+			failing := make(map[string]bool)
+			for _, name := range tt.failing {
+				failing[name] = true
+			}
+			newHandler := func(name string) Handler {
+				return func(ctx context.Context, ev *Event) error {
+					calls[name]++
+					if failing[name] {
+						return errors.New("handle failed")
+					}
+					return nil
+				}
+			}
+
+			deadLetter := &fakeDeadLetterQueue{}
+			registry := NewRegistry(&Config{}, deadLetter, fakeHandledStore{})
+			for _, name := range []string{"a", "b", "c"} {
+				registry.Register(name, newHandler(name), RetryPolicy{MaxAttempts: 2}, TypeFollow)
+			}
+
+			ev := NewEvent(TypeFollow, 1, 2)
+			for i := 1; i <= tt.deliveries; i++ {
+				deadLetter.fail = i < tt.deliveries
+				err := registry.Dispatch(context.Background(), ev)
+				if deadLetter.fail && len(tt.failing) > 0 {
+					if !errors.Is(err, errPush) {
+						t.Fatalf("Dispatch() delivery %d err = %v, want %v", i, err, errPush)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("Dispatch() delivery %d err = %v", i, err)
+				}
+			}
+
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			if !reflect.DeepEqual(deadLetter.handlers, tt.wantDeadLetter) {
+				t.Errorf("dead letters = %v, want %v", deadLetter.handlers, tt.wantDeadLetter)
+			}
+		})
+	}
+}
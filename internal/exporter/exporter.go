@@ -0,0 +1,258 @@
+package exporter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+// export modes
+const (
+	ModeFull        = "full"
+	ModeIncremental = "incremental"
+)
+
+const (
+	// DefaultPartitionRows 每个分区文件的行数
+	DefaultPartitionRows = 1000000
+	// scanBatchSize 每次从 DB 读取的行数
+	scanBatchSize = 5000
+	// incrementalOverlap 增量导出的起始时间提前的时长, 覆盖导出开始时还未提交的事务
+	incrementalOverlap = 10 * time.Minute
+
+	manifestFile = "manifest.json"
+	stateFile    = "_last_export.json"
+)
+
+var _tableUserFollowingName = (&model.UserFollowingModel{}).TableName()
+
+// Options export options
+type Options struct {
+	// Dir 导出的根目录, 文件写入 {Dir}/user_following/{mode}/{snapshot time}/
+	Dir string
+	// Format jsonl or parquet
+	Format string
+	// PartitionRows 每个分区文件的行数
+	PartitionRows int
+	// Mode full or incremental, incremental exports the rows changed since the last export
+	Mode string
+	// Snapshot read all the rows in one REPEATABLE READ transaction, it keeps the undo log
+	// until the export finishes, so it should be enabled only when reading from a replica
+	Snapshot bool
+}
+
+// Partition a partition file in the manifest
+type Partition struct {
+	File   string `json:"file"`
+	Rows   int64  `json:"rows"`
+	MinID  int64  `json:"min_id"`
+	MaxID  int64  `json:"max_id"`
+	Bytes  int64  `json:"bytes"`
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest the summary of an export, it is written after all the partitions,
+// so an export is complete only if its manifest exists.
+type Manifest struct {
+	Table  string `json:"table"`
+	Mode   string `json:"mode"`
+	Format string `json:"format"`
+	// SnapshotAt 导出开始的时间, 下一次增量导出从这里开始
+	SnapshotAt time.Time `json:"snapshot_at"`
+	// UpdatedSince 增量导出的起始时间, 与上一次导出有重叠, 使用方需要按 id 去重并保留 updated_at 最新的行
+	UpdatedSince *time.Time `json:"updated_since,omitempty"`
+	// MaxID 导出时的最大主键, 之后新增的行不在本次导出中
+	MaxID int64 `json:"max_id"`
+	// Snapshot 是否为一致性快照, 否则导出期间修改的行可能是修改后的状态, 由下一次增量导出覆盖
+	Snapshot   bool        `json:"snapshot"`
+	Rows       int64       `json:"rows"`
+	Partitions []Partition `json:"partitions"`
+	FinishedAt time.Time   `json:"finished_at"`
+}
+
+// state the last successful export, used to find the start of the next incremental export
+type state struct {
+	Mode       string    `json:"mode"`
+	SnapshotAt time.Time `json:"snapshot_at"`
+	Path       string    `json:"path"`
+}
+
+// Exporter export user_following into partition files
+type Exporter struct {
+	db   *gorm.DB
+	repo repository.UserFollowingRepo
+	opts Options
+}
+
+// New new an exporter
+func New(db *gorm.DB, repo repository.UserFollowingRepo, opts Options) *Exporter {
+	if opts.PartitionRows <= 0 {
+		opts.PartitionRows = DefaultPartitionRows
+	}
+	if opts.Format == "" {
+		opts.Format = FormatParquet
+	}
+	if opts.Mode == "" {
+		opts.Mode = ModeFull
+	}
+	return &Exporter{db: db, repo: repo, opts: opts}
+}
+
+// Run export the table by the primary key ranges, the rows are read in one read-only transaction
+// if Snapshot is set, so the partitions are a consistent snapshot and no lock is held on the table.
+func (e *Exporter) Run(ctx context.Context) (*Manifest, error) {
+	if e.opts.Format != FormatJSONL && e.opts.Format != FormatParquet {
+		return nil, fmt.Errorf("unsupported format: %s", e.opts.Format)
+	}
+	baseDir := filepath.Join(e.opts.Dir, _tableUserFollowingName)
+
+	manifest := &Manifest{
+		Table:      _tableUserFollowingName,
+		Mode:       e.opts.Mode,
+		Format:     e.opts.Format,
+		SnapshotAt: time.Now(),
+		Snapshot:   e.opts.Snapshot,
+		Partitions: make([]Partition, 0),
+	}
+	var since time.Time
+	if e.opts.Mode == ModeIncremental {
+		last, err := readState(baseDir)
+		if err != nil {
+			return nil, err
+		}
+		if last == nil {
+			// nothing to increase from
+			log.Warnf("[exporter] no previous export is found, run a full export instead")
+			manifest.Mode = ModeFull
+		} else {
+			since = last.SnapshotAt.Add(-incrementalOverlap)
+			manifest.UpdatedSince = &since
+		}
+	}
+
+	runDir := filepath.Join(baseDir, manifest.Mode, manifest.SnapshotAt.Format("20060102T150405"))
+	if err := os.MkdirAll(runDir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "[exporter] create dir err")
+	}
+
+	tx := e.db
+	if e.opts.Snapshot {
+		// the snapshot is established by the first read, all the later reads see the same data
+		tx = e.db.WithContext(ctx).Begin(&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+		if tx.Error != nil {
+			return nil, tx.Error
+		}
+		defer tx.Rollback()
+	}
+
+	maxID, err := e.repo.GetUserFollowingMaxID(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	manifest.MaxID = maxID
+
+	var (
+		part   *partitionFile
+		lastID int64
+	)
+	closePart := func() error {
+		if part == nil {
+			return nil
+		}
+		record, err := part.finish()
+		part = nil
+		if err != nil {
+			return errors.Wrap(err, "[exporter] close partition err")
+		}
+		manifest.Partitions = append(manifest.Partitions, record)
+		log.Infof("[exporter] partition %s done, rows: %d, max id: %d", record.File, record.Rows, record.MaxID)
+		return nil
+	}
+	for lastID < maxID {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		list, err := e.repo.ScanUserFollowing(ctx, tx, lastID, maxID, since, manifest.SnapshotAt, scanBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(list) == 0 {
+			break
+		}
+		for _, v := range list {
+			if part == nil {
+				name := fmt.Sprintf("part-%05d%s", len(manifest.Partitions), fileExt(e.opts.Format))
+				part, err = createPartition(filepath.Join(runDir, name), name, e.opts.Format)
+				if err != nil {
+					return nil, errors.Wrap(err, "[exporter] create partition err")
+				}
+			}
+			if err := part.add(v); err != nil {
+				return nil, errors.Wrap(err, "[exporter] write row err")
+			}
+			manifest.Rows++
+			if part.record.Rows >= int64(e.opts.PartitionRows) {
+				if err := closePart(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		lastID = list[len(list)-1].ID
+	}
+	if err := closePart(); err != nil {
+		return nil, err
+	}
+
+	manifest.FinishedAt = time.Now()
+	if err := writeJSON(filepath.Join(runDir, manifestFile), manifest); err != nil {
+		return nil, err
+	}
+	err = writeJSON(filepath.Join(baseDir, stateFile), &state{
+		Mode:       manifest.Mode,
+		SnapshotAt: manifest.SnapshotAt,
+		Path:       runDir,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func readState(baseDir string) (*state, error) {
+	b, err := os.ReadFile(filepath.Join(baseDir, stateFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "[exporter] read state err")
+	}
+	var s state
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, errors.Wrap(err, "[exporter] unmarshal state err")
+	}
+	return &s, nil
+}
+
+// writeJSON write to a temp file and rename it, so a reader never sees a partial file
+func writeJSON(path string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return errors.Wrapf(err, "[exporter] write %s err", filepath.Base(path))
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,185 @@
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	logger "github.com/go-eagle/eagle/pkg/log"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+func TestMain(m *testing.M) {
+	config.New("../../config", config.WithEnv("dev"))
+	logger.Init()
+	os.Exit(m.Run())
+}
+
+type fakeFollowingRepo struct {
+	repository.UserFollowingRepo
+	rows []*model.UserFollowingModel
+	// maxID the max id returned, the rows after it are inserted during the export
+	maxID int64
+	// since the updatedSince of the scans
+	since []time.Time
+}
+
+func (r *fakeFollowingRepo) GetUserFollowingMaxID(ctx context.Context, db *gorm.DB) (int64, error) {
+	return r.maxID, nil
+}
+
+func (r *fakeFollowingRepo) ScanUserFollowing(ctx context.Context, db *gorm.DB, lastID, maxID int64,
+	updatedSince, updatedUntil time.Time, limit int) ([]*model.UserFollowingModel, error) {
+	r.since = append(r.since, updatedSince)
+	ret := make([]*model.UserFollowingModel, 0, limit)
+	for _, v := range r.rows {
+		if v.ID <= lastID || v.ID > maxID {
+			continue
+		}
+		if !updatedSince.IsZero() && (v.UpdatedAt.Before(updatedSince) || !v.UpdatedAt.Before(updatedUntil)) {
+			continue
+		}
+		ret = append(ret, v)
+		if len(ret) == limit {
+			break
+		}
+	}
+	return ret, nil
+}
+
+func newRows(n int, updatedAt time.Time) []*model.UserFollowingModel {
+	rows := make([]*model.UserFollowingModel, 0, n)
+	for i := 1; i <= n; i++ {
+		rows = append(rows, &model.UserFollowingModel{
+			ID:          int64(i),
+			UserID:      int64(i),
+			FollowedUID: int64(i + 1),
+			Status:      1,
+			CreatedAt:   updatedAt,
+			UpdatedAt:   updatedAt,
+		})
+	}
+	return rows
+}
+
+func TestExporterRun(t *testing.T) {
+	old := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name string
+		rows []*model.UserFollowingModel
+		// maxID 0 means all the rows
+		maxID         int64
+		mode          string
+		lastExport    *state
+		partitionRows int
+		wantMode      string
+		wantRows      int64
+		wantParts     []int64
+		wantSince     bool
+	}{
+		{
+			name:          "full export by partitions",
+			rows:          newRows(12, old),
+			mode:          ModeFull,
+			partitionRows: 5,
+			wantMode:      ModeFull,
+			wantRows:      12,
+			wantParts:     []int64{5, 5, 2},
+		},
+		{
+			name:          "rows after max id are skipped",
+			rows:          newRows(12, old),
+			maxID:         7,
+			mode:          ModeFull,
+			partitionRows: 5,
+			wantMode:      ModeFull,
+			wantRows:      7,
+			wantParts:     []int64{5, 2},
+		},
+		{
+			name:          "incremental without previous export",
+			rows:          newRows(3, old),
+			mode:          ModeIncremental,
+			partitionRows: 5,
+			wantMode:      ModeFull,
+			wantRows:      3,
+			wantParts:     []int64{3},
+		},
+		{
+			name:          "incremental since the last export",
+			rows:          append(newRows(3, old), newRows(5, time.Now().Add(-time.Minute))[3:]...),
+			mode:          ModeIncremental,
+			lastExport:    &state{Mode: ModeFull, SnapshotAt: time.Now().Add(-time.Hour)},
+			partitionRows: 5,
+			wantMode:      ModeIncremental,
+			wantRows:      2,
+			wantParts:     []int64{2},
+			wantSince:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			baseDir := filepath.Join(dir, _tableUserFollowingName)
+			if tt.lastExport != nil {
+				if err := os.MkdirAll(baseDir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := writeJSON(filepath.Join(baseDir, stateFile), tt.lastExport); err != nil {
+					t.Fatal(err)
+				}
+			}
+			repo := &fakeFollowingRepo{rows: tt.rows, maxID: tt.maxID}
+			if repo.maxID == 0 {
+				repo.maxID = tt.rows[len(tt.rows)-1].ID
+			}
+
+			manifest, err := New(nil, repo, Options{
+				Dir:           dir,
+				Format:        FormatJSONL,
+				PartitionRows: tt.partitionRows,
+				Mode:          tt.mode,
+			}).Run(context.Background())
+			if err != nil {
+				t.Fatalf("Run() err = %v", err)
+			}
+
+			if manifest.Mode != tt.wantMode {
+				t.Errorf("Run() mode = %s, want %s", manifest.Mode, tt.wantMode)
+			}
+			if manifest.Rows != tt.wantRows {
+				t.Errorf("Run() rows = %d, want %d", manifest.Rows, tt.wantRows)
+			}
+			parts := make([]int64, 0, len(manifest.Partitions))
+			for _, v := range manifest.Partitions {
+				parts = append(parts, v.Rows)
+			}
+			if !reflect.DeepEqual(parts, tt.wantParts) {
+				t.Errorf("Run() partitions = %v, want %v", parts, tt.wantParts)
+			}
+			if (manifest.UpdatedSince != nil) != tt.wantSince {
+				t.Errorf("Run() updated since = %v, want set %v", manifest.UpdatedSince, tt.wantSince)
+			}
+			if tt.wantSince && !repo.since[0].Equal(tt.lastExport.SnapshotAt.Add(-incrementalOverlap)) {
+				t.Errorf("scan since = %v, want %v", repo.since[0], tt.lastExport.SnapshotAt.Add(-incrementalOverlap))
+			}
+
+			// the next incremental export starts from this one
+			last, err := readState(filepath.Join(dir, _tableUserFollowingName))
+			if err != nil {
+				t.Fatalf("readState() err = %v", err)
+			}
+			if !last.SnapshotAt.Equal(manifest.SnapshotAt) || last.Mode != manifest.Mode {
+				t.Errorf("state = %+v, want snapshot at %v", last, manifest.SnapshotAt)
+			}
+		})
+	}
+}
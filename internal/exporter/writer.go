@@ -0,0 +1,173 @@
+package exporter
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+// supported formats
+const (
+	FormatJSONL   = "jsonl"
+	FormatParquet = "parquet"
+)
+
+// Row an exported edge, the times are unix milliseconds
+type Row struct {
+	ID          int64  `json:"id" parquet:"name=id, type=INT64"`
+	UserID      int64  `json:"user_id" parquet:"name=user_id, type=INT64"`
+	FollowedUID int64  `json:"followed_uid" parquet:"name=followed_uid, type=INT64"`
+	Status      int32  `json:"status" parquet:"name=status, type=INT32"`
+	Remark      string `json:"remark" parquet:"name=remark, type=BYTE_ARRAY, convertedtype=UTF8"`
+	IsSpecial   bool   `json:"is_special" parquet:"name=is_special, type=BOOLEAN"`
+	IsMuted     bool   `json:"is_muted" parquet:"name=is_muted, type=BOOLEAN"`
+	Source      int32  `json:"source" parquet:"name=source, type=INT32"`
+	SourceMeta  string `json:"source_meta" parquet:"name=source_meta, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CreatedAt   int64  `json:"created_at" parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	UpdatedAt   int64  `json:"updated_at" parquet:"name=updated_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+func newRow(v *model.UserFollowingModel) *Row {
+	return &Row{
+		ID:          v.ID,
+		UserID:      v.UserID,
+		FollowedUID: v.FollowedUID,
+		Status:      int32(v.Status),
+		Remark:      v.Remark,
+		IsSpecial:   v.IsSpecial,
+		IsMuted:     v.IsMuted,
+		Source:      int32(v.Source),
+		SourceMeta:  v.SourceMeta,
+		CreatedAt:   v.CreatedAt.UnixMilli(),
+		UpdatedAt:   v.UpdatedAt.UnixMilli(),
+	}
+}
+
+// rowWriter encode rows into a partition file
+type rowWriter interface {
+	Write(row *Row) error
+	// Close flush the buffered rows, the underlying file is not closed
+	Close() error
+}
+
+// fileExt the file extension of the format
+func fileExt(format string) string {
+	if format == FormatParquet {
+		return ".parquet"
+	}
+	return ".jsonl.gz"
+}
+
+func newRowWriter(format string, w io.Writer) (rowWriter, error) {
+	switch format {
+	case FormatJSONL:
+		gw := gzip.NewWriter(w)
+		return &jsonlWriter{gw: gw, enc: json.NewEncoder(gw)}, nil
+	case FormatParquet:
+		pw, err := writer.NewParquetWriterFromWriter(w, new(Row), 4)
+		if err != nil {
+			return nil, err
+		}
+		pw.CompressionType = parquet.CompressionCodec_SNAPPY
+		return &parquetWriter{pw: pw}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// jsonlWriter gzip compressed json lines
+type jsonlWriter struct {
+	gw  *gzip.Writer
+	enc *json.Encoder
+}
+
+func (w *jsonlWriter) Write(row *Row) error {
+	return w.enc.Encode(row)
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.gw.Close()
+}
+
+// parquetWriter snappy compressed parquet
+type parquetWriter struct {
+	pw *writer.ParquetWriter
+}
+
+func (w *parquetWriter) Write(row *Row) error {
+	return w.pw.Write(row)
+}
+
+func (w *parquetWriter) Close() error {
+	return w.pw.WriteStop()
+}
+
+// partitionFile a partition being written, the checksum and size are computed while writing
+type partitionFile struct {
+	file   *os.File
+	hash   hash.Hash
+	bytes  int64
+	rows   rowWriter
+	record Partition
+}
+
+func createPartition(path, name, format string) (*partitionFile, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &partitionFile{file: f, hash: sha256.New(), record: Partition{File: name}}
+	rows, err := newRowWriter(format, io.MultiWriter(f, p.hash, p))
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	p.rows = rows
+	return p, nil
+}
+
+// Write count the written bytes
+func (p *partitionFile) Write(b []byte) (int, error) {
+	p.bytes += int64(len(b))
+	return len(b), nil
+}
+
+func (p *partitionFile) add(v *model.UserFollowingModel) error {
+	if err := p.rows.Write(newRow(v)); err != nil {
+		return err
+	}
+	if p.record.Rows == 0 {
+		p.record.MinID = v.ID
+	}
+	p.record.MaxID = v.ID
+	p.record.Rows++
+	return nil
+}
+
+// finish close the partition and return its manifest record
+func (p *partitionFile) finish() (Partition, error) {
+	if err := p.rows.Close(); err != nil {
+		_ = p.file.Close()
+		return p.record, err
+	}
+	if err := p.file.Sync(); err != nil {
+		_ = p.file.Close()
+		return p.record, err
+	}
+	if err := p.file.Close(); err != nil {
+		return p.record, err
+	}
+	p.record.Bytes = p.bytes
+	p.record.SHA256 = hex.EncodeToString(p.hash.Sum(nil))
+	return p.record, nil
+}
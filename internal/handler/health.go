@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-eagle/eagle/pkg/utils"
+	"github.com/go-microservice/relation-service/internal/cache"
+)
+
+// healthResponse the same as the health check of eagle, with the cache breaker states
+type healthResponse struct {
+	Status   string            `json:"status"`
+	Hostname string            `json:"hostname"`
+	Cache    map[string]string `json:"cache"`
+}
+
+// Health health check
+// status is DEGRADED when a redis circuit breaker is not closed, the requests are still
+// served by DB, so it returns 200 and should not be used to restart the instance.
+// @Summary health check
+// @Description health check with the cache breaker states
+// @Tags system
+// @Produce  json
+// @Router /health [get]
+func Health(c *gin.Context) {
+	status := "UP"
+	states := cache.BreakerStates()
+	for _, state := range states {
+		if state != cache.BreakerClosed.String() {
+			status = "DEGRADED"
+		}
+	}
+
+	c.JSON(http.StatusOK, healthResponse{
+		Status:   status,
+		Hostname: utils.GetHostname(),
+		Cache:    states,
+	})
+}
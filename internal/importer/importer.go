@@ -0,0 +1,325 @@
+package importer
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+const (
+	// DefaultBatchSize 每批写入的关系数
+	DefaultBatchSize = 1000
+	// recomputeBatchSize 每批重算计数的用户数
+	recomputeBatchSize = 500
+	// userCheckBatchSize 每次向用户服务查询的用户数
+	userCheckBatchSize = 500
+
+	followStatusNormal = 1
+)
+
+// Options import options
+type Options struct {
+	// Format csv or jsonl
+	Format string
+	// BatchSize 每批写入的关系数, 一批在一个事务中写入两张表
+	BatchSize int
+	// Offset 跳过前 Offset 行数据, 失败后使用报告中的 next_offset 继续导入
+	Offset int64
+	// DryRun 只校验和统计, 不写入
+	DryRun bool
+}
+
+// Report the summary of an import
+type Report struct {
+	DryRun bool `json:"dry_run"`
+	// Rows 读取的数据行数, 包含 offset 跳过的行
+	Rows    int64 `json:"rows"`
+	Skipped int64 `json:"skipped"`
+	Invalid int64 `json:"invalid"`
+	// SelfFollows 自己关注自己的行
+	SelfFollows int64 `json:"self_follows"`
+	// Duplicates 同一批次中重复的行和已关注的关系
+	Duplicates int64 `json:"duplicates"`
+	// Unavailable 关注者或被关注者不存在、已封禁或注销的行, 不导入
+	Unavailable int64 `json:"unavailable"`
+	Imported    int64 `json:"imported"`
+	Batches     int64 `json:"batches"`
+	// Users 重算了关注数和粉丝数的用户数, 每批提交后重算该批涉及的用户, 出现在多个批次中的用户会重复计数
+	Users int64 `json:"users"`
+	// NextOffset 已提交的行数, 中断后从这里继续
+	NextOffset int64  `json:"next_offset"`
+	Duration   string `json:"duration"`
+}
+
+// Importer import follow edges into user_following and user_follower
+// NOTE: the follow quota is not checked, the imported edges are the relations which exist in the source system
+// already, rejecting the edges over the limit would lose them in the migration. The users over the limit
+// can not follow more until they unfollow, as Follow checks the quota against the imported counts.
+type Importer struct {
+	db            *gorm.DB
+	followingRepo repository.UserFollowingRepo
+	followerRepo  repository.UserFollowerRepo
+	snapshotRepo  repository.UserRelationSnapshotRepo
+	userChecker   usercheck.UserChecker
+	invalidator   *repository.CacheInvalidator
+	opts          Options
+}
+
+// New new an importer
+func New(db *gorm.DB, followingRepo repository.UserFollowingRepo, followerRepo repository.UserFollowerRepo,
+	snapshotRepo repository.UserRelationSnapshotRepo, userChecker usercheck.UserChecker,
+	invalidator *repository.CacheInvalidator, opts Options) *Importer {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	return &Importer{
+		db:            db,
+		followingRepo: followingRepo,
+		followerRepo:  followerRepo,
+		snapshotRepo:  snapshotRepo,
+		userChecker:   userChecker,
+		invalidator:   invalidator,
+		opts:          opts,
+	}
+}
+
+// Run read all the rows and import them batch by batch, the report is returned even if it fails.
+// NOTE: duplicated rows in different batches are skipped by checking the DB,
+// so they are only reported as duplicates in a dry run if they are in the same batch.
+func (i *Importer) Run(ctx context.Context, r io.Reader) (*Report, error) {
+	start := time.Now()
+	report := &Report{DryRun: i.opts.DryRun, NextOffset: i.opts.Offset}
+	defer func() {
+		report.Duration = time.Since(start).String()
+	}()
+
+	reader, err := NewReader(r, i.opts.Format)
+	if err != nil {
+		return report, err
+	}
+
+	batch := make([]*Edge, 0, i.opts.BatchSize)
+	seen := make(map[[2]int64]struct{}, i.opts.BatchSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		edge, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, ErrInvalidRow) {
+			return report, err
+		}
+		report.Rows++
+		if report.Rows <= i.opts.Offset {
+			report.Skipped++
+			continue
+		}
+		if err != nil || edge.UserID <= 0 || edge.FollowedUID <= 0 {
+			report.Invalid++
+			log.Warnf("[importer] skip invalid row %d: %v", report.Rows, err)
+			continue
+		}
+		if edge.UserID == edge.FollowedUID {
+			report.SelfFollows++
+			continue
+		}
+		key := [2]int64{edge.UserID, edge.FollowedUID}
+		if _, ok := seen[key]; ok {
+			report.Duplicates++
+			continue
+		}
+		seen[key] = struct{}{}
+		batch = append(batch, edge)
+
+		if len(batch) >= i.opts.BatchSize {
+			if err := i.flush(ctx, batch, report); err != nil {
+				return report, err
+			}
+			report.NextOffset = report.Rows
+			batch = batch[:0]
+			seen = make(map[[2]int64]struct{}, i.opts.BatchSize)
+		}
+	}
+	if err := i.flush(ctx, batch, report); err != nil {
+		return report, err
+	}
+	report.NextOffset = report.Rows
+	return report, nil
+}
+
+// flush write a batch to both tables in a transaction, and recompute the counts of the users in the batch,
+// so that the committed batches are counted even if a later batch fails
+func (i *Importer) flush(ctx context.Context, batch []*Edge, report *Report) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	curTime := time.Now()
+	followings := make([]*model.UserFollowingModel, 0, len(batch))
+	for _, v := range batch {
+		createdAt := v.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = curTime
+		}
+		followings = append(followings, &model.UserFollowingModel{
+			UserID:      v.UserID,
+			FollowedUID: v.FollowedUID,
+			Status:      followStatusNormal,
+			CreatedAt:   createdAt,
+			UpdatedAt:   curTime,
+		})
+	}
+
+	// skip the edges of the users which can not follow or be followed, the same as Follow
+	followings, err := i.filterUnavailable(ctx, followings, report)
+	if err != nil {
+		return err
+	}
+
+	// skip the edges which are followed already
+	existing, err := i.followingRepo.GetActiveUserFollowing(ctx, followings)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		followed := make(map[[2]int64]struct{}, len(existing))
+		for _, v := range existing {
+			followed[[2]int64{v.UserID, v.FollowedUID}] = struct{}{}
+		}
+		filtered := followings[:0]
+		for _, v := range followings {
+			if _, ok := followed[[2]int64{v.UserID, v.FollowedUID}]; ok {
+				report.Duplicates++
+				continue
+			}
+			filtered = append(filtered, v)
+		}
+		followings = filtered
+	}
+
+	followers := make([]*model.UserFollowerModel, 0, len(followings))
+	for _, v := range followings {
+		followers = append(followers, &model.UserFollowerModel{
+			UserID:      v.FollowedUID,
+			FollowerUID: v.UserID,
+			Status:      followStatusNormal,
+			CreatedAt:   v.CreatedAt,
+			UpdatedAt:   curTime,
+		})
+	}
+
+	if !i.opts.DryRun && len(followings) > 0 {
+		// the caches are deleted after commit
+		ctx, invalidation := i.invalidator.Begin(ctx)
+		tx := i.db.Begin()
+		if tx.Error != nil {
+			return tx.Error
+		}
+		if err := i.followingRepo.BatchUpsertUserFollowing(ctx, tx, followings); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := i.followerRepo.BatchUpsertUserFollower(ctx, tx, followers); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit().Error; err != nil {
+			return errors.Wrap(err, "[importer] commit err")
+		}
+		invalidation.Commit(ctx)
+	}
+
+	// the followed edges are included, so that a batch resumed after the recompute failed is recomputed again
+	users := make(map[int64]struct{}, len(batch)*2)
+	for _, v := range batch {
+		users[v.UserID] = struct{}{}
+		users[v.FollowedUID] = struct{}{}
+	}
+	if err := i.recompute(ctx, users, report); err != nil {
+		return err
+	}
+	report.Imported += int64(len(followings))
+	report.Batches++
+	log.Infof("[importer] batch %d done, imported: %d, next offset: %d", report.Batches, report.Imported, report.Rows)
+	return nil
+}
+
+// filterUnavailable drop the edges whose follower or followed user is not found, banned or deactivated
+func (i *Importer) filterUnavailable(ctx context.Context, followings []*model.UserFollowingModel,
+	report *Report) ([]*model.UserFollowingModel, error) {
+	uids := make([]int64, 0, len(followings)*2)
+	seen := make(map[int64]struct{}, len(followings)*2)
+	for _, v := range followings {
+		for _, uid := range []int64{v.UserID, v.FollowedUID} {
+			if _, ok := seen[uid]; ok {
+				continue
+			}
+			seen[uid] = struct{}{}
+			uids = append(uids, uid)
+		}
+	}
+
+	statuses := make(map[int64]usercheck.Status, len(uids))
+	for start := 0; start < len(uids); start += userCheckBatchSize {
+		end := start + userCheckBatchSize
+		if end > len(uids) {
+			end = len(uids)
+		}
+		ret, err := i.userChecker.GetUserStatus(ctx, uids[start:end])
+		if err != nil {
+			return nil, errors.Wrap(err, "[importer] check users err")
+		}
+		for uid, status := range ret {
+			statuses[uid] = status
+		}
+	}
+
+	available := followings[:0]
+	for _, v := range followings {
+		if statuses[v.UserID].Err() != nil || statuses[v.FollowedUID].Err() != nil {
+			report.Unavailable++
+			continue
+		}
+		available = append(available, v)
+	}
+	return available, nil
+}
+
+// recompute refresh today's relation snapshot of the users, which holds the follower and following counts
+func (i *Importer) recompute(ctx context.Context, users map[int64]struct{}, report *Report) error {
+	report.Users += int64(len(users))
+	if i.opts.DryRun || len(users) == 0 {
+		return nil
+	}
+
+	today := time.Now()
+	userIDs := make([]int64, 0, recomputeBatchSize)
+	refresh := func() error {
+		_, err := i.snapshotRepo.RefreshUserRelationSnapshot(ctx, today, userIDs)
+		return err
+	}
+	for userID := range users {
+		userIDs = append(userIDs, userID)
+		if len(userIDs) >= recomputeBatchSize {
+			if err := refresh(); err != nil {
+				return err
+			}
+			userIDs = userIDs[:0]
+		}
+	}
+	if len(userIDs) > 0 {
+		return refresh()
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	logger "github.com/go-eagle/eagle/pkg/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+func TestMain(m *testing.M) {
+	config.New("../../config", config.WithEnv("dev"))
+	logger.Init()
+	os.Exit(m.Run())
+}
+
+var (
+	errUpsert    = errors.New("upsert failed")
+	errUserCheck = errors.New("user service unavailable")
+)
+
+type fakeFollowingRepo struct {
+	repository.UserFollowingRepo
+	// followed the active edges in DB
+	followed map[[2]int64]bool
+	// failAt fail the nth upsert, starts from 1
+	failAt  int
+	upserts int
+}
+
+func (r *fakeFollowingRepo) GetActiveUserFollowing(ctx context.Context, edges []*model.UserFollowingModel) ([]*model.UserFollowingModel, error) {
+	ret := make([]*model.UserFollowingModel, 0)
+	for _, v := range edges {
+		if r.followed[[2]int64{v.UserID, v.FollowedUID}] {
+			ret = append(ret, v)
+		}
+	}
+	return ret, nil
+}
+
+func (r *fakeFollowingRepo) BatchUpsertUserFollowing(ctx context.Context, db *gorm.DB, data []*model.UserFollowingModel) error {
+	r.upserts++
+	if r.upserts == r.failAt {
+		return errUpsert
+	}
+	for _, v := range data {
+		r.followed[[2]int64{v.UserID, v.FollowedUID}] = true
+	}
+	return nil
+}
+
+type fakeFollowerRepo struct {
+	repository.UserFollowerRepo
+}
+
+func (r *fakeFollowerRepo) BatchUpsertUserFollower(ctx context.Context, db *gorm.DB, data []*model.UserFollowerModel) error {
+	return nil
+}
+
+type fakeSnapshotRepo struct {
+	repository.UserRelationSnapshotRepo
+	refreshed map[int64]bool
+}
+
+func (r *fakeSnapshotRepo) RefreshUserRelationSnapshot(ctx context.Context, day time.Time, userIDs []int64) ([]*model.UserRelationSnapshotModel, error) {
+	for _, v := range userIDs {
+		r.refreshed[v] = true
+	}
+	return nil, nil
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite err: %v", err)
+	}
+	return db
+}
+
+func TestImporterRun(t *testing.T) {
+	const rows = "user_id,followed_uid\n1,2\n1,3\n2,3\n3,1\n4,5\n"
+
+	tests := []struct {
+		name     string
+		input    string
+		opts     Options
+		followed [][2]int64
+		// unavailable the users banned in the user service
+		unavailable []int64
+		checkErr    error
+		failAt      int
+		wantErr     error
+		want        Report
+		refreshed   []int64
+	}{
+		{
+			name:      "all batches",
+			input:     rows,
+			opts:      Options{Format: FormatCSV, BatchSize: 2},
+			want:      Report{Rows: 5, Imported: 5, Batches: 3, Users: 8, NextOffset: 5},
+			refreshed: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:      "resume from offset",
+			input:     rows,
+			opts:      Options{Format: FormatCSV, BatchSize: 2, Offset: 3},
+			want:      Report{Rows: 5, Skipped: 3, Imported: 2, Batches: 1, Users: 4, NextOffset: 5},
+			refreshed: []int64{1, 3, 4, 5},
+		},
+		{
+			name:      "stop at the failed batch",
+			input:     rows,
+			opts:      Options{Format: FormatCSV, BatchSize: 2},
+			failAt:    2,
+			wantErr:   errUpsert,
+			want:      Report{Rows: 4, Imported: 2, Batches: 1, Users: 3, NextOffset: 2},
+			refreshed: []int64{1, 2, 3},
+		},
+		{
+			name:  "skip invalid, self and duplicated rows",
+			input: "1,2\n1,1\nx,2\n1,2\n2,1\n",
+			opts:  Options{Format: FormatCSV, BatchSize: 10},
+			// 2 follows 1 already, the users are recomputed too
+			followed:  [][2]int64{{2, 1}},
+			want:      Report{Rows: 5, Invalid: 1, SelfFollows: 1, Duplicates: 2, Imported: 1, Batches: 1, Users: 2, NextOffset: 5},
+			refreshed: []int64{1, 2},
+		},
+		{
+			name:        "skip the unavailable users",
+			input:       rows,
+			opts:        Options{Format: FormatCSV, BatchSize: 10},
+			unavailable: []int64{3},
+			want:        Report{Rows: 5, Unavailable: 3, Imported: 2, Batches: 1, Users: 5, NextOffset: 5},
+			refreshed:   []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:      "stop if the users can not be checked",
+			input:     rows,
+			opts:      Options{Format: FormatCSV, BatchSize: 2},
+			checkErr:  errUserCheck,
+			wantErr:   errUserCheck,
+			want:      Report{Rows: 2},
+			refreshed: []int64{},
+		},
+		{
+			name:      "dry run",
+			input:     rows,
+			opts:      Options{Format: FormatCSV, BatchSize: 10, DryRun: true},
+			want:      Report{DryRun: true, Rows: 5, Imported: 5, Batches: 1, Users: 5, NextOffset: 5},
+			refreshed: []int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			followingRepo := &fakeFollowingRepo{followed: make(map[[2]int64]bool), failAt: tt.failAt}
+			for _, v := range tt.followed {
+				followingRepo.followed[v] = true
+			}
+			snapshotRepo := &fakeSnapshotRepo{refreshed: make(map[int64]bool)}
+			userChecker := usercheck.NewFakeChecker(1, 2, 3, 4, 5)
+			for _, v := range tt.unavailable {
+				userChecker.SetStatus(v, usercheck.StatusBanned)
+			}
+			userChecker.SetError(tt.checkErr)
+			invalidator, wait := repository.NewCacheInvalidator(nil)
+			defer wait()
+
+			imp := New(newTestDB(t), followingRepo, &fakeFollowerRepo{}, snapshotRepo, userChecker, invalidator, tt.opts)
+			report, err := imp.Run(context.Background(), strings.NewReader(tt.input))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Run() err = %v, want %v", err, tt.wantErr)
+			}
+
+			report.Duration = ""
+			if *report != tt.want {
+				t.Errorf("Run() report = %+v, want %+v", *report, tt.want)
+			}
+			refreshed := make([]int64, 0, len(snapshotRepo.refreshed))
+			for v := range snapshotRepo.refreshed {
+				refreshed = append(refreshed, v)
+			}
+			sort.Slice(refreshed, func(i, j int) bool { return refreshed[i] < refreshed[j] })
+			if !reflect.DeepEqual(refreshed, tt.refreshed) {
+				t.Errorf("refreshed users = %v, want %v", refreshed, tt.refreshed)
+			}
+		})
+	}
+}
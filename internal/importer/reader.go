@@ -0,0 +1,177 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// supported formats
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// the layouts of created_at, a unix timestamp in seconds is supported too
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// ErrInvalidRow the row can not be parsed, it is skipped and the import goes on
+var ErrInvalidRow = errors.New("invalid row")
+
+// Edge a follow edge to import, user_id follows followed_uid
+type Edge struct {
+	UserID      int64
+	FollowedUID int64
+	CreatedAt   time.Time
+}
+
+// Reader read edges row by row, it returns io.EOF at the end,
+// an error wrapping ErrInvalidRow means only the current row is bad.
+type Reader interface {
+	Read() (*Edge, error)
+}
+
+// NewReader new a reader of the format
+func NewReader(r io.Reader, format string) (Reader, error) {
+	switch format {
+	case FormatCSV:
+		cr := csv.NewReader(bufio.NewReader(r))
+		cr.FieldsPerRecord = -1
+		cr.ReuseRecord = true
+		return &csvReader{r: cr, columns: []string{"user_id", "followed_uid", "created_at"}}, nil
+	case FormatJSONL:
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 64*1024), 1024*1024)
+		return &jsonlReader{sc: sc}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// csvReader the columns are user_id,followed_uid,created_at by default,
+// a header row is used to find the columns if it exists.
+type csvReader struct {
+	r       *csv.Reader
+	columns []string
+	started bool
+}
+
+func (c *csvReader) Read() (*Edge, error) {
+	record, err := c.r.Read()
+	if err != nil {
+		if isParseError(err) {
+			return nil, errors.Wrap(ErrInvalidRow, err.Error())
+		}
+		return nil, err
+	}
+
+	// the first row is a header if the first column is not a number
+	if !c.started {
+		c.started = true
+		if len(record) > 0 {
+			if _, err := strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64); err != nil {
+				c.columns = make([]string, 0, len(record))
+				for _, v := range record {
+					c.columns = append(c.columns, strings.ToLower(strings.TrimSpace(v)))
+				}
+				return c.Read()
+			}
+		}
+	}
+
+	values := make(map[string]string, len(record))
+	for i, v := range record {
+		if i < len(c.columns) {
+			values[c.columns[i]] = strings.TrimSpace(v)
+		}
+	}
+	edge := &Edge{}
+	if edge.UserID, err = strconv.ParseInt(values["user_id"], 10, 64); err != nil {
+		return nil, errors.Wrapf(ErrInvalidRow, "user_id: %q", values["user_id"])
+	}
+	if edge.FollowedUID, err = strconv.ParseInt(values["followed_uid"], 10, 64); err != nil {
+		return nil, errors.Wrapf(ErrInvalidRow, "followed_uid: %q", values["followed_uid"])
+	}
+	if edge.CreatedAt, err = parseTime(values["created_at"]); err != nil {
+		return nil, err
+	}
+	return edge, nil
+}
+
+type jsonlRow struct {
+	UserID      json.Number     `json:"user_id"`
+	FollowedUID json.Number     `json:"followed_uid"`
+	CreatedAt   json.RawMessage `json:"created_at"`
+}
+
+type jsonlReader struct {
+	sc *bufio.Scanner
+}
+
+func (j *jsonlReader) Read() (*Edge, error) {
+	var line []byte
+	for len(line) == 0 {
+		if !j.sc.Scan() {
+			if err := j.sc.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		line = []byte(strings.TrimSpace(j.sc.Text()))
+	}
+
+	var row jsonlRow
+	if err := json.Unmarshal(line, &row); err != nil {
+		return nil, errors.Wrap(ErrInvalidRow, err.Error())
+	}
+	edge := &Edge{}
+	var err error
+	if edge.UserID, err = row.UserID.Int64(); err != nil {
+		return nil, errors.Wrapf(ErrInvalidRow, "user_id: %q", row.UserID)
+	}
+	if edge.FollowedUID, err = row.FollowedUID.Int64(); err != nil {
+		return nil, errors.Wrapf(ErrInvalidRow, "followed_uid: %q", row.FollowedUID)
+	}
+
+	// created_at is a string or a unix timestamp
+	createdAt := strings.Trim(string(row.CreatedAt), `"`)
+	if createdAt == "null" {
+		createdAt = ""
+	}
+	if edge.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, err
+	}
+	return edge, nil
+}
+
+// parseTime a zero time is returned if it is empty, the caller uses the import time instead
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(ts, 0), nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Wrapf(ErrInvalidRow, "created_at: %q", s)
+}
+
+func isParseError(err error) bool {
+	var perr *csv.ParseError
+	return errors.As(err, &perr)
+}
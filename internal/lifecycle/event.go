@@ -0,0 +1,35 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+)
+
+// user lifecycle event types, published by the user service
+const (
+	// TypeUserDeleted 用户注销
+	TypeUserDeleted = "user.deleted"
+	// TypeUserBanned 用户被封禁, 关系和取关一样被删除, 解封后不会恢复
+	TypeUserBanned = "user.banned"
+	// TypeUserMerged 用户被合并到 MergedInto
+	TypeUserMerged = "user.merged"
+)
+
+// UserEvent a lifecycle event of an account
+type UserEvent struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	UserID int64  `json:"user_id"`
+	// MergedInto 合并后保留的用户, 只有合并事件有
+	MergedInto int64     `json:"merged_into,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Handler handle an event, the event is redelivered if an error is returned
+type Handler func(ctx context.Context, ev *UserEvent) error
+
+// Subscriber the source of user events, eg: redis stream, kafka
+type Subscriber interface {
+	// Subscribe block and deliver the events to handler until ctx is done
+	Subscribe(ctx context.Context, handler Handler) error
+}
@@ -0,0 +1,423 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/event"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/quota"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+const (
+	// DefaultEdgeBatchSize 每个事务处理的关系数
+	DefaultEdgeBatchSize = 500
+
+	followStatusNormal      = 1
+	followStatusDelete      = 0
+	closeFriendStatusDelete = 0
+)
+
+// the phases of a cursor, the followings are processed before the followers
+const (
+	PhaseFollowing = "following"
+	PhaseFollower  = "follower"
+	PhaseDone      = "done"
+)
+
+// ErrTargetUnavailable the user the edges are transferred to does not exist, or is banned or deactivated
+var ErrTargetUnavailable = errors.New("the user to transfer to is unavailable")
+
+// Cursor the progress of processing a user's edges, it is saved by the caller to resume
+type Cursor struct {
+	Phase string `json:"phase"`
+	// LastID 当前阶段已处理的最大主键
+	LastID int64 `json:"last_id"`
+}
+
+// Done whether all the edges are processed
+func (c Cursor) Done() bool {
+	return c.Phase == PhaseDone
+}
+
+// Stats the number of processed edges
+type Stats struct {
+	// Removed 取消的关系数
+	Removed int64 `json:"removed"`
+	// Transferred 转移给新用户的关系数
+	Transferred int64 `json:"transferred"`
+	// Duplicates 新用户已有的关系和两个用户之间的关系, 只取消不转移
+	Duplicates int64 `json:"duplicates"`
+	// OverLimit 超过新用户关注数限制的关注, 不取消不转移, 保留在原用户上
+	OverLimit int64 `json:"over_limit"`
+	// Unavailable 对方用户不存在、已封禁或注销的关系, 不取消不转移, 保留在原用户上
+	Unavailable int64 `json:"unavailable"`
+}
+
+// Graph remove or transfer all the edges of a user batch by batch, each batch is written in a transaction
+// with the same side effects as unfollow, and the caches are deleted after commit. The relation events of
+// the edges are published after commit, so the counts of the other users are updated by the consumer.
+type Graph struct {
+	db              *gorm.DB
+	followingRepo   repository.UserFollowingRepo
+	followerRepo    repository.UserFollowerRepo
+	closeFriendRepo repository.UserCloseFriendRepo
+	groupMemberRepo repository.RelationGroupMemberRepo
+	relationLogRepo repository.RelationLogRepo
+	invalidator     *repository.CacheInvalidator
+	publisher       event.Publisher
+	quotaChecker    *quota.Checker
+	userChecker     usercheck.UserChecker
+	batchSize       int
+}
+
+// NewGraph new a graph, the followings transferred to the new user are not limited if quotaChecker is nil,
+// and the targets of the transferred edges are not checked if userChecker is nil
+func NewGraph(db *gorm.DB, followingRepo repository.UserFollowingRepo, followerRepo repository.UserFollowerRepo,
+	closeFriendRepo repository.UserCloseFriendRepo, groupMemberRepo repository.RelationGroupMemberRepo,
+	relationLogRepo repository.RelationLogRepo, invalidator *repository.CacheInvalidator, publisher event.Publisher,
+	quotaChecker *quota.Checker, userChecker usercheck.UserChecker, batchSize int) *Graph {
+	if batchSize <= 0 {
+		batchSize = DefaultEdgeBatchSize
+	}
+	return &Graph{
+		db:              db,
+		followingRepo:   followingRepo,
+		followerRepo:    followerRepo,
+		closeFriendRepo: closeFriendRepo,
+		groupMemberRepo: groupMemberRepo,
+		relationLogRepo: relationLogRepo,
+		invalidator:     invalidator,
+		publisher:       publisher,
+		quotaChecker:    quotaChecker,
+		userChecker:     userChecker,
+		batchSize:       batchSize,
+	}
+}
+
+// RemoveUserEdges unfollow all the followings and followers of the user
+func (g *Graph) RemoveUserEdges(ctx context.Context, userID int64) (*Stats, error) {
+	stats := &Stats{}
+	cursor := Cursor{}
+	for !cursor.Done() {
+		next, err := g.Step(ctx, userID, 0, cursor, stats)
+		if err != nil {
+			return stats, err
+		}
+		cursor = next
+	}
+	return stats, nil
+}
+
+// CheckTarget return ErrTargetUnavailable if the edges can not be transferred to toUID,
+// it is checked once before a transfer, so the edges are not removed for nothing
+func (g *Graph) CheckTarget(ctx context.Context, toUID int64) error {
+	if g.userChecker == nil {
+		return nil
+	}
+	if err := usercheck.CheckUser(ctx, g.userChecker, toUID); err != nil {
+		if errors.Is(err, usercheck.ErrUserNotFound) || errors.Is(err, usercheck.ErrUserUnavailable) {
+			return ErrTargetUnavailable
+		}
+		return err
+	}
+	return nil
+}
+
+// TransferUserEdges move all the followings and followers of fromUID to toUID
+func (g *Graph) TransferUserEdges(ctx context.Context, fromUID, toUID int64) (*Stats, error) {
+	if err := g.CheckTarget(ctx, toUID); err != nil {
+		return nil, err
+	}
+	stats := &Stats{}
+	cursor := Cursor{}
+	for !cursor.Done() {
+		next, err := g.Step(ctx, fromUID, toUID, cursor, stats)
+		if err != nil {
+			return stats, err
+		}
+		cursor = next
+	}
+	return stats, nil
+}
+
+// Step process a batch from the cursor and return the next cursor. The edges of fromUID are unfollowed,
+// and they are followed by toUID instead if toUID is not 0, except the self edges and the existing ones.
+// The edges which can not be transferred, because of the quota of toUID or the other user is unavailable,
+// are left on fromUID. The caller should check toUID by CheckTarget before the first step.
+func (g *Graph) Step(ctx context.Context, fromUID, toUID int64, cursor Cursor, stats *Stats) (Cursor, error) {
+	switch cursor.Phase {
+	case "", PhaseFollowing:
+		rows, err := g.followingRepo.ScanUserFollowingByUser(ctx, g.db, fromUID, cursor.LastID, g.batchSize)
+		if err != nil {
+			return cursor, err
+		}
+		if len(rows) == 0 {
+			return Cursor{Phase: PhaseFollower}, nil
+		}
+		// fromUID -> v.FollowedUID becomes toUID -> v.FollowedUID
+		edges := make([]*edge, 0, len(rows))
+		for _, v := range rows {
+			edges = append(edges, &edge{
+				old:   v,
+				newTo: &model.UserFollowingModel{UserID: toUID, FollowedUID: v.FollowedUID},
+			})
+		}
+		// the followings of toUID increase, the followers do not follow more users
+		if err := g.apply(ctx, toUID, edges, true, stats); err != nil {
+			return cursor, err
+		}
+		return Cursor{Phase: PhaseFollowing, LastID: rows[len(rows)-1].ID}, nil
+	case PhaseFollower:
+		rows, err := g.followerRepo.ScanUserFollowerByUser(ctx, g.db, fromUID, cursor.LastID, g.batchSize)
+		if err != nil {
+			return cursor, err
+		}
+		if len(rows) == 0 {
+			return Cursor{Phase: PhaseDone}, nil
+		}
+		// v.FollowerUID -> fromUID becomes v.FollowerUID -> toUID
+		edges := make([]*edge, 0, len(rows))
+		for _, v := range rows {
+			edges = append(edges, &edge{
+				old: &model.UserFollowingModel{
+					UserID:      v.FollowerUID,
+					FollowedUID: fromUID,
+					CreatedAt:   v.CreatedAt,
+				},
+				newTo: &model.UserFollowingModel{UserID: v.FollowerUID, FollowedUID: toUID},
+			})
+		}
+		if err := g.apply(ctx, toUID, edges, false, stats); err != nil {
+			return cursor, err
+		}
+		return Cursor{Phase: PhaseFollower, LastID: rows[len(rows)-1].ID}, nil
+	default:
+		return Cursor{Phase: PhaseDone}, nil
+	}
+}
+
+// edge an edge to be unfollowed and the edge to replace it
+type edge struct {
+	old   *model.UserFollowingModel
+	newTo *model.UserFollowingModel
+}
+
+// apply unfollow the edges and transfer them to toUID, following is true for the followings of fromUID,
+// whose followed users are the other users, otherwise the followers are the other users.
+func (g *Graph) apply(ctx context.Context, toUID int64, edges []*edge, following bool, stats *Stats) error {
+	// the edges to unfollow, all of them are unfollowed if they are not transferred
+	removes := edges
+	// the edges to transfer, the self edges and the existing ones are unfollowed only
+	transfers := make([]*edge, 0, len(edges))
+	var overLimit, unavailable int
+	if toUID > 0 {
+		candidates := make([]*model.UserFollowingModel, 0, len(edges))
+		for _, v := range edges {
+			if v.newTo.UserID != v.newTo.FollowedUID {
+				candidates = append(candidates, v.newTo)
+			}
+		}
+		existing, err := g.followingRepo.GetActiveUserFollowing(ctx, candidates)
+		if err != nil {
+			return err
+		}
+		followed := make(map[[2]int64]struct{}, len(existing))
+		for _, v := range existing {
+			followed[[2]int64{v.UserID, v.FollowedUID}] = struct{}{}
+		}
+		duplicates := make([]*edge, 0)
+		for _, v := range edges {
+			key := [2]int64{v.newTo.UserID, v.newTo.FollowedUID}
+			if _, ok := followed[key]; ok || key[0] == key[1] {
+				duplicates = append(duplicates, v)
+				continue
+			}
+			// the duplicated edges in the batch, eg: from follows to and to follows from
+			followed[key] = struct{}{}
+			transfers = append(transfers, v)
+		}
+
+		// the edges of the nonexistent, banned or deactivated users are not transferred
+		if g.userChecker != nil && len(transfers) > 0 {
+			n := len(transfers)
+			transfers, err = g.filterUnavailable(ctx, transfers, following)
+			if err != nil {
+				return err
+			}
+			unavailable = n - len(transfers)
+		}
+
+		if following && g.quotaChecker != nil && len(transfers) > 0 {
+			q, err := g.quotaChecker.GetQuota(ctx, toUID)
+			if err != nil {
+				return err
+			}
+			if !q.Unlimited() && int64(len(transfers)) > q.Remaining() {
+				overLimit = len(transfers) - int(q.Remaining())
+				transfers = transfers[:q.Remaining()]
+			}
+		}
+
+		// the edges which can not be transferred are kept
+		removes = append(duplicates, transfers...)
+	}
+
+	ctx, invalidation := g.invalidator.Begin(ctx)
+	tx := g.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	curTime := time.Now()
+	for _, v := range removes {
+		if err := g.unfollow(ctx, tx, v.old, curTime); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	for _, v := range transfers {
+		if err := g.follow(ctx, tx, v.newTo, v.old, curTime); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	invalidation.Commit(ctx)
+	g.publishEvents(ctx, removes, transfers)
+
+	stats.Removed += int64(len(removes))
+	stats.Transferred += int64(len(transfers))
+	if toUID > 0 {
+		stats.Duplicates += int64(len(removes) - len(transfers))
+		stats.OverLimit += int64(overLimit)
+		stats.Unavailable += int64(unavailable)
+	}
+	return nil
+}
+
+// publishEvents publish the unfollows and the transfers of a committed batch, they are marked as batch events
+func (g *Graph) publishEvents(ctx context.Context, edges, transfers []*edge) {
+	evs := make([]*event.Event, 0, len(edges)+len(transfers))
+	for _, v := range edges {
+		evs = append(evs, event.NewEvent(event.TypeUnfollow, v.old.UserID, v.old.FollowedUID))
+	}
+	for _, v := range transfers {
+		ev := event.NewEvent(event.TypeFollow, v.newTo.UserID, v.newTo.FollowedUID)
+		ev.Source = int32(v.old.Source)
+		evs = append(evs, ev)
+	}
+	for _, ev := range evs {
+		ev.Batch = true
+		if err := g.publisher.Publish(ctx, ev); err != nil {
+			log.WithContext(ctx).Warnf("[lifecycle] publish event %s err: %+v, user_id: %d, target_uid: %d",
+				ev.Type, err, ev.UserID, ev.TargetUID)
+		}
+	}
+}
+
+// filterUnavailable drop the edges whose other user is unavailable, it is the followed user of a following
+// and the follower of a follower, toUID is checked before the transfer
+func (g *Graph) filterUnavailable(ctx context.Context, edges []*edge, following bool) ([]*edge, error) {
+	other := func(v *edge) int64 {
+		if following {
+			return v.newTo.FollowedUID
+		}
+		return v.newTo.UserID
+	}
+	uids := make([]int64, 0, len(edges))
+	seen := make(map[int64]struct{}, len(edges))
+	for _, v := range edges {
+		if _, ok := seen[other(v)]; ok {
+			continue
+		}
+		seen[other(v)] = struct{}{}
+		uids = append(uids, other(v))
+	}
+	statuses, err := g.userChecker.GetUserStatus(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]*edge, 0, len(edges))
+	for _, v := range edges {
+		if statuses[other(v)].Err() == nil {
+			available = append(available, v)
+		}
+	}
+	return available, nil
+}
+
+// unfollow the same writes as the Unfollow rpc
+func (g *Graph) unfollow(ctx context.Context, tx *gorm.DB, v *model.UserFollowingModel, curTime time.Time) error {
+	if err := g.followingRepo.UpdateUserFollowingStatus(ctx, tx, v.UserID, v.FollowedUID, followStatusDelete); err != nil {
+		return err
+	}
+	if err := g.followerRepo.UpdateUserFollowerStatus(ctx, tx, v.FollowedUID, v.UserID, followStatusDelete); err != nil {
+		return err
+	}
+	if err := g.groupMemberRepo.DeleteGroupMemberByRelation(ctx, tx, v.UserID, v.FollowedUID); err != nil {
+		return err
+	}
+	// a close friend must follow the user
+	err := g.closeFriendRepo.UpdateUserCloseFriendStatus(ctx, tx, v.FollowedUID, v.UserID, closeFriendStatusDelete)
+	if err != nil {
+		return err
+	}
+	_, err = g.relationLogRepo.CreateRelationLog(ctx, tx, &model.RelationLogModel{
+		UserID:       v.UserID,
+		TargetUID:    v.FollowedUID,
+		Action:       model.RelationLogActionUnfollow,
+		Source:       model.RelationLogSourceBatch,
+		FollowSource: v.Source,
+		CreatedAt:    curTime,
+	})
+	return err
+}
+
+// follow create the new edge, the follow time and source of the old edge are kept
+func (g *Graph) follow(ctx context.Context, tx *gorm.DB, v, old *model.UserFollowingModel, curTime time.Time) error {
+	createdAt := old.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = curTime
+	}
+	_, err := g.followingRepo.CreateUserFollowing(ctx, tx, &model.UserFollowingModel{
+		UserID:      v.UserID,
+		FollowedUID: v.FollowedUID,
+		Status:      followStatusNormal,
+		Source:      old.Source,
+		SourceMeta:  old.SourceMeta,
+		CreatedAt:   createdAt,
+		UpdatedAt:   curTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = g.followerRepo.CreateUserFollower(ctx, tx, &model.UserFollowerModel{
+		UserID:      v.FollowedUID,
+		FollowerUID: v.UserID,
+		Status:      followStatusNormal,
+		CreatedAt:   createdAt,
+		UpdatedAt:   curTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = g.relationLogRepo.CreateRelationLog(ctx, tx, &model.RelationLogModel{
+		UserID:       v.UserID,
+		TargetUID:    v.FollowedUID,
+		Action:       model.RelationLogActionFollow,
+		Source:       model.RelationLogSourceBatch,
+		FollowSource: old.Source,
+		CreatedAt:    curTime,
+	})
+	return err
+}
@@ -0,0 +1,317 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	logger "github.com/go-eagle/eagle/pkg/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/event"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/quota"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+func TestMain(m *testing.M) {
+	config.New("../../config", config.WithEnv("dev"))
+	logger.Init()
+	os.Exit(m.Run())
+}
+
+// store the rows of both tables, the follower rows mirror the following rows
+type store struct {
+	nextID    int64
+	following map[[2]int64]*model.UserFollowingModel
+	follower  map[[2]int64]*model.UserFollowerModel
+}
+
+func newStore(edges [][2]int64) *store {
+	s := &store{
+		following: make(map[[2]int64]*model.UserFollowingModel),
+		follower:  make(map[[2]int64]*model.UserFollowerModel),
+	}
+	for _, v := range edges {
+		s.follow(v[0], v[1])
+	}
+	return s
+}
+
+func (s *store) follow(userID, followedUID int64) {
+	if v, ok := s.following[[2]int64{userID, followedUID}]; ok {
+		v.Status = followStatusNormal
+	} else {
+		s.nextID++
+		s.following[[2]int64{userID, followedUID}] = &model.UserFollowingModel{
+			ID: s.nextID, UserID: userID, FollowedUID: followedUID, Status: followStatusNormal,
+		}
+	}
+	if v, ok := s.follower[[2]int64{followedUID, userID}]; ok {
+		v.Status = followStatusNormal
+	} else {
+		s.nextID++
+		s.follower[[2]int64{followedUID, userID}] = &model.UserFollowerModel{
+			ID: s.nextID, UserID: followedUID, FollowerUID: userID, Status: followStatusNormal,
+		}
+	}
+}
+
+// active the active edges in order
+func (s *store) active() [][2]int64 {
+	ret := make([][2]int64, 0)
+	for k, v := range s.following {
+		if v.Status == followStatusNormal {
+			ret = append(ret, k)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i][0] < ret[j][0] || ret[i][0] == ret[j][0] && ret[i][1] < ret[j][1]
+	})
+	return ret
+}
+
+type fakeFollowingRepo struct {
+	repository.UserFollowingRepo
+	s *store
+}
+
+func (r *fakeFollowingRepo) ScanUserFollowingByUser(ctx context.Context, db *gorm.DB, userID, lastID int64, limit int) ([]*model.UserFollowingModel, error) {
+	ret := make([]*model.UserFollowingModel, 0)
+	for _, v := range r.s.following {
+		if v.UserID == userID && v.ID > lastID && v.Status == followStatusNormal {
+			ret = append(ret, v)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
+
+func (r *fakeFollowingRepo) GetActiveUserFollowing(ctx context.Context, edges []*model.UserFollowingModel) ([]*model.UserFollowingModel, error) {
+	ret := make([]*model.UserFollowingModel, 0)
+	for _, e := range edges {
+		if v, ok := r.s.following[[2]int64{e.UserID, e.FollowedUID}]; ok && v.Status == followStatusNormal {
+			ret = append(ret, v)
+		}
+	}
+	return ret, nil
+}
+
+func (r *fakeFollowingRepo) UpdateUserFollowingStatus(ctx context.Context, db *gorm.DB, userID, followedUID int64, status int) error {
+	if v, ok := r.s.following[[2]int64{userID, followedUID}]; ok {
+		v.Status = status
+	}
+	return nil
+}
+
+func (r *fakeFollowingRepo) CreateUserFollowing(ctx context.Context, db *gorm.DB, data *model.UserFollowingModel) (int64, error) {
+	r.s.follow(data.UserID, data.FollowedUID)
+	return 0, nil
+}
+
+func (r *fakeFollowingRepo) CountUserFollowing(ctx context.Context, userID int64) (int64, error) {
+	var n int64
+	for _, v := range r.s.following {
+		if v.UserID == userID && v.Status == followStatusNormal {
+			n++
+		}
+	}
+	return n, nil
+}
+
+type fakeFollowerRepo struct {
+	repository.UserFollowerRepo
+	s *store
+}
+
+func (r *fakeFollowerRepo) ScanUserFollowerByUser(ctx context.Context, db *gorm.DB, userID, lastID int64, limit int) ([]*model.UserFollowerModel, error) {
+	ret := make([]*model.UserFollowerModel, 0)
+	for _, v := range r.s.follower {
+		if v.UserID == userID && v.ID > lastID && v.Status == followStatusNormal {
+			ret = append(ret, v)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
+
+func (r *fakeFollowerRepo) UpdateUserFollowerStatus(ctx context.Context, db *gorm.DB, userID, followerUID int64, status int) error {
+	if v, ok := r.s.follower[[2]int64{userID, followerUID}]; ok {
+		v.Status = status
+	}
+	return nil
+}
+
+func (r *fakeFollowerRepo) CreateUserFollower(ctx context.Context, db *gorm.DB, data *model.UserFollowerModel) (int64, error) {
+	return 0, nil
+}
+
+type fakeCloseFriendRepo struct {
+	repository.UserCloseFriendRepo
+}
+
+func (r *fakeCloseFriendRepo) UpdateUserCloseFriendStatus(ctx context.Context, db *gorm.DB, userID, friendUID int64, status int) error {
+	return nil
+}
+
+type fakeGroupMemberRepo struct {
+	repository.RelationGroupMemberRepo
+}
+
+func (r *fakeGroupMemberRepo) DeleteGroupMemberByRelation(ctx context.Context, db *gorm.DB, userID, followedUID int64) error {
+	return nil
+}
+
+type fakeRelationLogRepo struct {
+	repository.RelationLogRepo
+}
+
+func (r *fakeRelationLogRepo) CreateRelationLog(ctx context.Context, db *gorm.DB, data *model.RelationLogModel) (int64, error) {
+	return 0, nil
+}
+
+type fakePublisher struct {
+	events []*event.Event
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, ev *event.Event) error {
+	p.events = append(p.events, ev)
+	return nil
+}
+
+// fixedTier all the users are in the same tier
+type fixedTier string
+
+func (t fixedTier) GetUserTier(ctx context.Context, userID int64) (string, error) {
+	return string(t), nil
+}
+
+func TestGraphTransferUserEdges(t *testing.T) {
+	const fromUID, toUID = 1, 2
+
+	tests := []struct {
+		name  string
+		edges [][2]int64
+		// unavailable the banned users, the others are normal
+		unavailable []int64
+		// limit the followings limit of toUID, 0 means unlimited
+		limit      int64
+		wantErr    error
+		wantStats  Stats
+		wantEdges  [][2]int64
+		wantEvents int
+	}{
+		{
+			name:       "transfer followings and followers",
+			edges:      [][2]int64{{1, 3}, {1, 4}, {5, 1}},
+			wantStats:  Stats{Removed: 3, Transferred: 3},
+			wantEdges:  [][2]int64{{2, 3}, {2, 4}, {5, 2}},
+			wantEvents: 6,
+		},
+		{
+			name: "self edges and existing edges are removed only",
+			// 1 -> 2 and 2 -> 1 become self edges, 2 follows 3 already
+			edges:      [][2]int64{{1, 2}, {2, 1}, {1, 3}, {2, 3}, {1, 4}},
+			wantStats:  Stats{Removed: 4, Transferred: 1, Duplicates: 3},
+			wantEdges:  [][2]int64{{2, 3}, {2, 4}},
+			wantEvents: 5,
+		},
+		{
+			name: "followings over the limit are kept",
+			// 2 can follow 2 more users
+			edges:      [][2]int64{{2, 9}, {1, 3}, {1, 4}, {1, 5}, {6, 1}},
+			limit:      3,
+			wantStats:  Stats{Removed: 3, Transferred: 3, OverLimit: 1},
+			wantEdges:  [][2]int64{{1, 5}, {2, 3}, {2, 4}, {2, 9}, {6, 2}},
+			wantEvents: 6,
+		},
+		{
+			name: "edges of the unavailable users are kept",
+			// 4 is followed by 1 and 6 follows 1
+			edges:       [][2]int64{{1, 3}, {1, 4}, {5, 1}, {6, 1}},
+			unavailable: []int64{4, 6},
+			wantStats:   Stats{Removed: 2, Transferred: 2, Unavailable: 2},
+			wantEdges:   [][2]int64{{1, 4}, {2, 3}, {5, 2}, {6, 1}},
+			wantEvents:  4,
+		},
+		{
+			name:        "unavailable target",
+			edges:       [][2]int64{{1, 3}, {5, 1}},
+			unavailable: []int64{toUID},
+			wantErr:     ErrTargetUnavailable,
+			wantEdges:   [][2]int64{{1, 3}, {5, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStore(tt.edges)
+			followingRepo := &fakeFollowingRepo{s: s}
+			userChecker := usercheck.NewFakeChecker(1, 2, 3, 4, 5, 6, 7, 8, 9)
+			for _, uid := range tt.unavailable {
+				userChecker.SetStatus(uid, usercheck.StatusBanned)
+			}
+			quotaCfg := &quota.Config{Enable: tt.limit > 0, DefaultTier: quota.TierRegular,
+				Tiers: map[string]int64{quota.TierRegular: tt.limit}}
+			quotaChecker := quota.NewChecker(quotaCfg, fixedTier(quota.TierRegular), followingRepo)
+			db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+			if err != nil {
+				t.Fatalf("open sqlite err: %v", err)
+			}
+			invalidator, wait := repository.NewCacheInvalidator(nil)
+			defer wait()
+			publisher := &fakePublisher{}
+
+			// a small batch to go through the cursors
+			graph := NewGraph(db, followingRepo, &fakeFollowerRepo{s: s}, &fakeCloseFriendRepo{}, &fakeGroupMemberRepo{},
+				&fakeRelationLogRepo{}, invalidator, publisher, quotaChecker, userChecker, 2)
+			stats, err := graph.TransferUserEdges(context.Background(), fromUID, toUID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TransferUserEdges() err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && *stats != tt.wantStats {
+				t.Errorf("TransferUserEdges() stats = %+v, want %+v", *stats, tt.wantStats)
+			}
+			if got := s.active(); !reflect.DeepEqual(got, tt.wantEdges) {
+				t.Errorf("active edges = %v, want %v", got, tt.wantEdges)
+			}
+			if len(publisher.events) != tt.wantEvents {
+				t.Errorf("published events = %d, want %d", len(publisher.events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestGraphRemoveUserEdges(t *testing.T) {
+	s := newStore([][2]int64{{1, 2}, {1, 3}, {4, 1}, {2, 3}})
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite err: %v", err)
+	}
+	invalidator, wait := repository.NewCacheInvalidator(nil)
+	defer wait()
+
+	graph := NewGraph(db, &fakeFollowingRepo{s: s}, &fakeFollowerRepo{s: s}, &fakeCloseFriendRepo{}, &fakeGroupMemberRepo{},
+		&fakeRelationLogRepo{}, invalidator, &fakePublisher{}, nil, nil, 2)
+	stats, err := graph.RemoveUserEdges(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("RemoveUserEdges() err = %v", err)
+	}
+	if want := (Stats{Removed: 3}); *stats != want {
+		t.Errorf("RemoveUserEdges() stats = %+v, want %+v", *stats, want)
+	}
+	if got, want := s.active(), [][2]int64{{2, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("active edges = %v, want %v", got, want)
+	}
+}
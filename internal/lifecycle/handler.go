@@ -0,0 +1,61 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-eagle/eagle/pkg/log"
+)
+
+// EventHandler keep the graph in sync with the accounts, the edges of a deleted or banned user
+// are unfollowed, and the edges of a merged user are transferred to the user it is merged into.
+// A ban is irreversible for the graph: the edges are unfollowed like the ones of a deleted user,
+// and they are not restored when the user is unbanned. The user service should only publish
+// user.banned for the permanent bans.
+// The handling is idempotent, a redelivered event only processes the edges which are left.
+type EventHandler struct {
+	graph *Graph
+}
+
+// NewEventHandler new a user event handler
+func NewEventHandler(graph *Graph) *EventHandler {
+	return &EventHandler{graph: graph}
+}
+
+// Handle implements Handler
+func (h *EventHandler) Handle(ctx context.Context, ev *UserEvent) error {
+	if ev.UserID <= 0 {
+		log.WithContext(ctx).Warnf("[lifecycle] skip event %s, invalid user_id: %d", ev.ID, ev.UserID)
+		return nil
+	}
+
+	var (
+		stats *Stats
+		err   error
+	)
+	switch ev.Type {
+	case TypeUserDeleted, TypeUserBanned:
+		stats, err = h.graph.RemoveUserEdges(ctx, ev.UserID)
+	case TypeUserMerged:
+		if ev.MergedInto <= 0 || ev.MergedInto == ev.UserID {
+			log.WithContext(ctx).Warnf("[lifecycle] skip event %s, invalid merged_into: %d", ev.ID, ev.MergedInto)
+			return nil
+		}
+		stats, err = h.graph.TransferUserEdges(ctx, ev.UserID, ev.MergedInto)
+	default:
+		return nil
+	}
+	if errors.Is(err, ErrTargetUnavailable) {
+		// the edges are kept on the merged user, a redelivery can not make it succeed
+		log.WithContext(ctx).Errorf("[lifecycle] skip event %s, merged_into %d is unavailable", ev.ID, ev.MergedInto)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.WithContext(ctx).Infof("[lifecycle] event %s of user %d done, removed: %d, transferred: %d, duplicates: %d, "+
+		"over limit: %d, unavailable: %d", ev.Type, ev.UserID, stats.Removed, stats.Transferred, stats.Duplicates,
+		stats.OverLimit, stats.Unavailable)
+	return nil
+}
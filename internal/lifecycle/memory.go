@@ -0,0 +1,55 @@
+package lifecycle
+
+import (
+	"context"
+	"sync"
+)
+
+var _ Subscriber = (*MemorySubscriber)(nil)
+
+// MemorySubscriber deliver the published events in process, it is used by tests and tools.
+// The events are handled one by one, a failed event is kept in Failed instead of being redelivered.
+type MemorySubscriber struct {
+	ch chan *UserEvent
+
+	mu     sync.Mutex
+	failed []*UserEvent
+}
+
+// NewMemorySubscriber new a memory subscriber which buffers size events
+func NewMemorySubscriber(size int) *MemorySubscriber {
+	return &MemorySubscriber{ch: make(chan *UserEvent, size)}
+}
+
+// Publish add an event, it blocks if the buffer is full
+func (s *MemorySubscriber) Publish(ctx context.Context, ev *UserEvent) error {
+	select {
+	case s.ch <- ev:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Subscribe implements Subscriber
+func (s *MemorySubscriber) Subscribe(ctx context.Context, handler Handler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-s.ch:
+			if err := handler(ctx, ev); err != nil {
+				s.mu.Lock()
+				s.failed = append(s.failed, ev)
+				s.mu.Unlock()
+			}
+		}
+	}
+}
+
+// Failed the events which the handler returned an error for
+func (s *MemorySubscriber) Failed() []*UserEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*UserEvent(nil), s.failed...)
+}
@@ -0,0 +1,128 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultStreamField     = "message"
+	defaultStreamBatchSize = 100
+	defaultStreamBlock     = 5 * time.Second
+)
+
+// Config user lifecycle consumer config, see config/{env}/consumer.yaml
+type Config struct {
+	Enable bool
+	// Stream 用户服务写入账号事件的 redis stream
+	Stream string
+	// Group 消费组, 多个实例共用一个消费组
+	Group string
+	// Consumer 消费者名称, 为空时使用 hostname
+	Consumer string
+	// Field 消息中存放 json 的字段名
+	Field     string
+	BatchSize int64
+	Block     time.Duration
+	// EdgeBatchSize 每个事务处理的关系数
+	EdgeBatchSize int
+}
+
+var _ Subscriber = (*redisStreamSubscriber)(nil)
+
+// redisStreamSubscriber read the events from a redis stream with consumer group
+type redisStreamSubscriber struct {
+	rdb *redis.Client
+	cfg Config
+}
+
+// NewRedisStreamSubscriber new a redis stream subscriber
+func NewRedisStreamSubscriber(rdb *redis.Client, cfg Config) Subscriber {
+	if cfg.Consumer == "" {
+		cfg.Consumer, _ = os.Hostname()
+	}
+	if cfg.Field == "" {
+		cfg.Field = defaultStreamField
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultStreamBatchSize
+	}
+	if cfg.Block <= 0 {
+		cfg.Block = defaultStreamBlock
+	}
+	return &redisStreamSubscriber{rdb: rdb, cfg: cfg}
+}
+
+// Subscribe consume the stream, the message is acked after it is handled
+func (s *redisStreamSubscriber) Subscribe(ctx context.Context, handler Handler) error {
+	err := s.rdb.XGroupCreateMkStream(ctx, s.cfg.Stream, s.cfg.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group err: %v", err)
+	}
+
+	// the pending messages of last run are handled first
+	id := "0"
+	for ctx.Err() == nil {
+		streams, err := s.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.cfg.Group,
+			Consumer: s.cfg.Consumer,
+			Streams:  []string{s.cfg.Stream, id},
+			Count:    s.cfg.BatchSize,
+			Block:    s.cfg.Block,
+		}).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			log.Warnf("read stream %s err: %v", s.cfg.Stream, err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		var messages []redis.XMessage
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+		if id == "0" && len(messages) == 0 {
+			id = ">"
+			continue
+		}
+		for _, msg := range messages {
+			if err := s.handle(ctx, msg, handler); err != nil {
+				// not acked, it is redelivered on next start
+				log.Warnf("handle user event %s err: %v", msg.ID, err)
+				continue
+			}
+			_ = s.rdb.XAck(ctx, s.cfg.Stream, s.cfg.Group, msg.ID).Err()
+		}
+		if id != ">" {
+			id = messages[len(messages)-1].ID
+		}
+	}
+	return nil
+}
+
+func (s *redisStreamSubscriber) handle(ctx context.Context, msg redis.XMessage, handler Handler) error {
+	raw, ok := msg.Values[s.cfg.Field].(string)
+	if !ok {
+		// can not be handled anyway, skip it
+		log.Warnf("field %s not found in message %s", s.cfg.Field, msg.ID)
+		return nil
+	}
+	var ev UserEvent
+	if err := json.Unmarshal([]byte(raw), &ev); err != nil {
+		log.Warnf("decode message %s err: %v", msg.ID, err)
+		return nil
+	}
+	return handler(ctx, &ev)
+}
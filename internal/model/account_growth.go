@@ -0,0 +1,7 @@
+package model
+
+// AccountGrowth 用户在一段时间内的粉丝净增长数
+type AccountGrowth struct {
+	UID    int64 `gorm:"column:uid" json:"uid"`
+	Growth int64 `gorm:"column:growth" json:"growth"`
+}
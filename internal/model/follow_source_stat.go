@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// FollowSourceStatModel 关注来源日统计表
+type FollowSourceStatModel struct {
+	ID            int64     `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"-"`
+	StatDate      time.Time `gorm:"column:stat_date" json:"stat_date"`
+	Source        int       `gorm:"column:source" json:"source"`
+	FollowCount   int64     `gorm:"column:follow_count" json:"follow_count"`
+	UnfollowCount int64     `gorm:"column:unfollow_count" json:"unfollow_count"`
+	CreatedAt     time.Time `gorm:"column:created_at" json:"-"`
+	UpdatedAt     time.Time `gorm:"column:updated_at" json:"-"`
+}
+
+// TableName sets the insert table name for this struct type
+func (f *FollowSourceStatModel) TableName() string {
+	return "follow_source_stat"
+}
@@ -0,0 +1,7 @@
+package model
+
+// FollowSuggestion 推荐关注的用户, 由二度关系计算得出
+type FollowSuggestion struct {
+	UID         int64 `gorm:"column:uid" json:"uid"`
+	MutualCount int64 `gorm:"column:mutual_count" json:"mutual_count"`
+}
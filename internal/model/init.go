@@ -1,10 +1,14 @@
 package model
 
 import (
+	"github.com/go-eagle/eagle/pkg/config"
 	"github.com/go-eagle/eagle/pkg/storage/orm"
 	"gorm.io/gorm"
 )
 
+// ReplicaName the name of the read only replica in database.yaml
+const ReplicaName = "replica"
+
 var (
 	// DB define a gloabl db
 	DB *gorm.DB
@@ -18,7 +22,7 @@ func Init() (*gorm.DB, func(), error) {
 	}
 
 	// get first db
-	DB, err := orm.GetDB("default")
+	DB, err = orm.GetDB("default")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -39,3 +43,20 @@ func Init() (*gorm.DB, func(), error) {
 func GetDB() *gorm.DB {
 	return DB
 }
+
+// GetReplicaDB get the read only replica, used by the jobs scanning a whole table.
+// ok is false if no replica is configured in database.yaml, the primary db is returned then.
+func GetReplicaDB() (db *gorm.DB, ok bool, err error) {
+	v, err := config.LoadWithType("database", "yaml")
+	if err != nil {
+		return nil, false, err
+	}
+	if !v.IsSet(ReplicaName) {
+		return DB, false, nil
+	}
+	db, err = orm.GetDB(ReplicaName)
+	if err != nil {
+		return nil, false, err
+	}
+	return db, true, nil
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// RelationGroupModel 关注分组表
+type RelationGroupModel struct {
+	ID        int64     `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"id"`
+	UserID    int64     `gorm:"column:user_id" json:"user_id"`
+	Name      string    `gorm:"column:name" json:"name"`
+	Status    int       `gorm:"column:status" json:"status"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// TableName sets the insert table name for this struct type
+func (r *RelationGroupModel) TableName() string {
+	return "relation_group"
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// RelationGroupMemberModel 分组成员表, 每条记录对应 user_following 中的一条关注关系
+type RelationGroupMemberModel struct {
+	ID          int64     `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"-"`
+	GroupID     int64     `gorm:"column:group_id" json:"group_id"`
+	UserID      int64     `gorm:"column:user_id" json:"user_id"`
+	FollowedUID int64     `gorm:"column:followed_uid" json:"followed_uid"`
+	Status      int       `gorm:"column:status" json:"status"`
+	CreatedAt   time.Time `gorm:"column:created_at" json:"-"`
+	UpdatedAt   time.Time `gorm:"column:updated_at" json:"-"`
+}
+
+// TableName sets the insert table name for this struct type
+func (r *RelationGroupMemberModel) TableName() string {
+	return "relation_group_member"
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+const (
+	// RelationLogActionFollow 关注
+	RelationLogActionFollow = "follow"
+	// RelationLogActionUnfollow 取消关注
+	RelationLogActionUnfollow = "unfollow"
+
+	// RelationLogSourceApp 来源-用户通过 app 调用 RelationService 操作
+	RelationLogSourceApp = "app"
+	// RelationLogSourceWeb 来源-用户通过 web 网关调用 RelationService 操作, 由网关的 token 确定
+	RelationLogSourceWeb = "web"
+	// RelationLogSourceAdmin 来源-管理后台
+	RelationLogSourceAdmin = "admin"
+	// RelationLogSourceBatch 来源-批量任务
+	RelationLogSourceBatch = "batch"
+)
+
+// RelationLogModel 关系变更日志表, 只追加不修改
+type RelationLogModel struct {
+	ID        int64  `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"id"`
+	UserID    int64  `gorm:"column:user_id" json:"user_id"`
+	TargetUID int64  `gorm:"column:target_uid" json:"target_uid"`
+	Action    string `gorm:"column:action" json:"action"`
+	Source    string `gorm:"column:source" json:"source"`
+	// FollowSource 关注关系的来源, 取关时为被取关的关注关系的来源
+	FollowSource int       `gorm:"column:follow_source" json:"follow_source"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+// TableName sets the insert table name for this struct type
+func (r *RelationLogModel) TableName() string {
+	return "relation_log"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// UserBlockModel 拉黑表
+type UserBlockModel struct {
+	ID         int64     `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"-"`
+	UserID     int64     `gorm:"column:user_id" json:"user_id"`
+	BlockedUID int64     `gorm:"column:blocked_uid" json:"blocked_uid"`
+	Status     int       `gorm:"column:status" json:"status"`
+	CreatedAt  time.Time `gorm:"column:created_at" json:"-"`
+	UpdatedAt  time.Time `gorm:"column:updated_at" json:"-"`
+}
+
+// TableName sets the insert table name for this struct type
+func (u *UserBlockModel) TableName() string {
+	return "user_block"
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// UserCloseFriendModel 密友表, 由 user_id 单方面维护, friend_uid 必须是 user_id 的粉丝
+type UserCloseFriendModel struct {
+	ID        int64     `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"-"`
+	UserID    int64     `gorm:"column:user_id" json:"user_id"`
+	FriendUID int64     `gorm:"column:friend_uid" json:"friend_uid"`
+	Status    int       `gorm:"column:status" json:"status"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"-"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"-"`
+}
+
+// TableName sets the insert table name for this struct type
+func (u *UserCloseFriendModel) TableName() string {
+	return "user_close_friend"
+}
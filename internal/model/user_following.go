@@ -8,6 +8,11 @@ type UserFollowingModel struct {
 	UserID      int64     `gorm:"column:user_id" json:"user_id"`
 	FollowedUID int64     `gorm:"column:followed_uid" json:"followed_uid"`
 	Status      int       `gorm:"column:status" json:"status"`
+	Remark      string    `gorm:"column:remark" json:"remark"`
+	IsSpecial   bool      `gorm:"column:is_special" json:"is_special"`
+	IsMuted     bool      `gorm:"column:is_muted" json:"is_muted"`
+	Source      int       `gorm:"column:source" json:"source"`
+	SourceMeta  string    `gorm:"column:source_meta" json:"source_meta"`
 	CreatedAt   time.Time `gorm:"column:created_at" json:"-"`
 	UpdatedAt   time.Time `gorm:"column:updated_at" json:"-"`
 }
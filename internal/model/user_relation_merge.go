@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+const (
+	// UserRelationMergeStatusPending 等待执行
+	UserRelationMergeStatusPending = 0
+	// UserRelationMergeStatusRunning 执行中
+	UserRelationMergeStatusRunning = 1
+	// UserRelationMergeStatusDone 已完成
+	UserRelationMergeStatusDone = 2
+	// UserRelationMergeStatusFailed 重试后仍然失败, 可以重新发起
+	UserRelationMergeStatusFailed = 3
+)
+
+// UserRelationMergeModel 账号合并时关系转移的审计记录, 同时保存任务的进度用于断点续传
+type UserRelationMergeModel struct {
+	ID      int64 `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"id"`
+	FromUID int64 `gorm:"column:from_uid" json:"from_uid"`
+	ToUID   int64 `gorm:"column:to_uid" json:"to_uid"`
+	Status  int   `gorm:"column:status" json:"status"`
+	// Phase 和 LastID 是任务的游标
+	Phase       string    `gorm:"column:phase" json:"phase"`
+	LastID      int64     `gorm:"column:last_id" json:"last_id"`
+	Removed     int64     `gorm:"column:removed" json:"removed"`
+	Transferred int64     `gorm:"column:transferred" json:"transferred"`
+	Duplicates  int64     `gorm:"column:duplicates" json:"duplicates"`
+	OverLimit   int64     `gorm:"column:over_limit" json:"over_limit"`
+	Unavailable int64     `gorm:"column:unavailable" json:"unavailable"`
+	Reason      string    `gorm:"column:reason" json:"reason"`
+	Error       string    `gorm:"column:error" json:"error"`
+	CreatedAt   time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at" json:"updated_at"`
+	// FinishedAt 完成或失败的时间, 执行中为 NULL
+	FinishedAt *time.Time `gorm:"column:finished_at" json:"finished_at"`
+}
+
+// TableName sets the insert table name for this struct type
+func (u *UserRelationMergeModel) TableName() string {
+	return "user_relation_merge"
+}
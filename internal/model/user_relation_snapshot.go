@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// UserRelationSnapshotModel 用户关系数每日快照, 只记录当天有变化的用户, 没有记录的日期沿用之前的值
+type UserRelationSnapshotModel struct {
+	ID             int64     `gorm:"primary_key;AUTO_INCREMENT;column:id" json:"-"`
+	UserID         int64     `gorm:"column:user_id" json:"user_id"`
+	StatDate       time.Time `gorm:"column:stat_date" json:"stat_date"`
+	FollowerCount  int64     `gorm:"column:follower_count" json:"follower_count"`
+	FollowingCount int64     `gorm:"column:following_count" json:"following_count"`
+	FollowerGain   int64     `gorm:"column:follower_gain" json:"follower_gain"`
+	FollowerLoss   int64     `gorm:"column:follower_loss" json:"follower_loss"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"-"`
+}
+
+// TableName sets the insert table name for this struct type
+func (u *UserRelationSnapshotModel) TableName() string {
+	return "user_relation_snapshot"
+}
@@ -0,0 +1,54 @@
+package quota
+
+import (
+	"github.com/go-eagle/eagle/pkg/config"
+)
+
+// the tiers of the accounts
+const (
+	TierRegular  = "regular"
+	TierVerified = "verified"
+	TierBusiness = "business"
+)
+
+const (
+	defaultTier             = TierRegular
+	defaultRegularFollowing = 5000
+)
+
+// Config follow quota config, see config/{env}/quota.yaml
+type Config struct {
+	// Enable 是否限制关注数, 关闭时不限制
+	Enable bool
+	// DefaultTier 获取不到用户等级或等级未配置时使用的等级, 用户等级由用户服务返回
+	DefaultTier string
+	// Tiers 各等级的最大关注数, key 为等级, 小于等于 0 表示不限制
+	Tiers map[string]int64
+}
+
+// LoadConf load quota config
+func LoadConf() (*Config, error) {
+	v, err := config.LoadWithType("quota", "yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	var c Config
+	if err := v.Unmarshal(&c); err != nil {
+		return nil, err
+	}
+	c.setDefaults()
+	return &c, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.DefaultTier == "" {
+		c.DefaultTier = defaultTier
+	}
+	if c.Tiers == nil {
+		c.Tiers = map[string]int64{}
+	}
+	if _, ok := c.Tiers[TierRegular]; !ok {
+		c.Tiers[TierRegular] = defaultRegularFollowing
+	}
+}
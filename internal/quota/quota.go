@@ -0,0 +1,100 @@
+package quota
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/wire"
+
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+// ProviderSet is quota providers.
+var ProviderSet = wire.NewSet(LoadConf, NewUserTierProvider, NewChecker)
+
+// ErrLimitExceeded the user follows as many users as the limit of the tier
+var ErrLimitExceeded = errors.New("follow limit exceeded")
+
+// Quota the follow quota of a user
+type Quota struct {
+	Tier string
+	// Limit 最大关注数, 小于等于 0 表示不限制
+	Limit int64
+	// Used 当前关注数, 不限制时不统计
+	Used int64
+}
+
+// Unlimited whether the user can follow any number of users
+func (q *Quota) Unlimited() bool {
+	return q.Limit <= 0
+}
+
+// Remaining the number of users can be followed, it is meaningless if unlimited
+func (q *Quota) Remaining() int64 {
+	if q.Used >= q.Limit {
+		return 0
+	}
+	return q.Limit - q.Used
+}
+
+// Checker check the followings of a user against the limit of the tier.
+// The check and the insert are not atomic, so the concurrent follows may exceed the limit slightly.
+type Checker struct {
+	cfg           *Config
+	tierProvider  UserTierProvider
+	followingRepo repository.UserFollowingRepo
+}
+
+// NewChecker new a checker
+func NewChecker(cfg *Config, tierProvider UserTierProvider, followingRepo repository.UserFollowingRepo) *Checker {
+	return &Checker{
+		cfg:           cfg,
+		tierProvider:  tierProvider,
+		followingRepo: followingRepo,
+	}
+}
+
+// GetQuota get the limit of the tier and the number of followings counted from the table
+func (c *Checker) GetQuota(ctx context.Context, userID int64) (*Quota, error) {
+	q, err := c.getLimit(ctx, userID)
+	if err != nil || q.Unlimited() {
+		return q, err
+	}
+	q.Used, err = c.followingRepo.CountUserFollowing(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// getLimit get the tier and its limit, the quota is unlimited if it is disabled
+func (c *Checker) getLimit(ctx context.Context, userID int64) (*Quota, error) {
+	if !c.cfg.Enable {
+		return &Quota{}, nil
+	}
+
+	tier, err := c.tierProvider.GetUserTier(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	limit, ok := c.cfg.Tiers[tier]
+	if !ok {
+		tier = c.cfg.DefaultTier
+		limit = c.cfg.Tiers[tier]
+	}
+	return &Quota{Tier: tier, Limit: limit}, nil
+}
+
+// CheckFollow check whether the user can follow one more user before the insert, ErrLimitExceeded is returned
+// with the quota. The followings are counted from the table by the index of user_id and status,
+// the counters updated by the events lag behind the follows and are not used.
+func (c *Checker) CheckFollow(ctx context.Context, userID int64) (*Quota, error) {
+	q, err := c.GetQuota(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !q.Unlimited() && q.Remaining() == 0 {
+		return q, ErrLimitExceeded
+	}
+	return q, nil
+}
@@ -0,0 +1,19 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+// UserTierProvider get the tier of a user, eg: regular, verified, business.
+// An empty tier means unknown, and the default tier is used.
+type UserTierProvider interface {
+	GetUserTier(ctx context.Context, userID int64) (string, error)
+}
+
+// NewUserTierProvider the tier is got from the user service by the user checker, so it can not be
+// forged by the caller. The tiers are unknown if the user check is disabled.
+func NewUserTierProvider(checker usercheck.UserChecker) UserTierProvider {
+	return checker
+}
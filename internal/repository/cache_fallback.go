@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/pkg/errors"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+)
+
+// cacheUnavailable a cache error other than a miss, eg: redis is down or the breaker is open
+func cacheUnavailable(err error) bool {
+	return err != nil && !errors.Is(err, redis.ErrRedisNotFound)
+}
+
+// loadFromDB run the DB query, the concurrency is bounded by the breaker
+// when it falls back from an unavailable cache.
+func loadFromDB(ctx context.Context, breaker *cache.Breaker, cacheErr error, fn func() error) error {
+	if !cacheUnavailable(cacheErr) {
+		return fn()
+	}
+	return breaker.Fallback(ctx, fn)
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+)
+
+const (
+	// defaultDoubleDeleteDelay 延迟二次删除的间隔, 需大于一次 DB 读+回填缓存的耗时
+	defaultDoubleDeleteDelay = 500 * time.Millisecond
+)
+
+type invalidationBatchKey struct{}
+
+// CacheInvalidator defer the cache invalidations of a transaction until it is committed,
+// a cache deleted before commit may be filled back with the old row by a concurrent read.
+// The invalidations are run once again after a delay to evict the stale values
+// written back by the reads which started before commit.
+type CacheInvalidator struct {
+	delay time.Duration
+	// pending the delayed deletions which are not run yet
+	pending sync.WaitGroup
+	mu      sync.Mutex
+	// closed no more delayed deletions are scheduled after Wait is called
+	closed bool
+}
+
+// NewCacheInvalidator new a cache invalidator, the cleanup waits for the delayed deletions
+// and must be called before the process exits, otherwise they are lost with the process.
+func NewCacheInvalidator(cfg *cache.Config) (*CacheInvalidator, func()) {
+	delay := defaultDoubleDeleteDelay
+	if cfg != nil && cfg.DoubleDeleteDelay > 0 {
+		delay = cfg.DoubleDeleteDelay
+	}
+	i := &CacheInvalidator{delay: delay}
+	return i, i.Wait
+}
+
+// Delay the interval of the delayed deletion
+func (i *CacheInvalidator) Delay() time.Duration {
+	return i.delay
+}
+
+// Wait block until the delayed deletions are done, if the process crashes before them,
+// the stale values are kept until they expire.
+// The batches committed after it is called run the delayed deletions in place.
+func (i *CacheInvalidator) Wait() {
+	i.mu.Lock()
+	i.closed = true
+	i.mu.Unlock()
+	i.pending.Wait()
+}
+
+// schedule run fn after the delay in background, false is returned if Wait is called already
+func (i *CacheInvalidator) schedule(fn func()) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.closed {
+		return false
+	}
+	// added under the lock, so that it never races with Wait on a zero counter
+	i.pending.Add(1)
+	time.AfterFunc(i.delay, func() {
+		defer i.pending.Done()
+		fn()
+	})
+	return true
+}
+
+// Begin return a context which collects the invalidations of the repos,
+// it should be used with the transaction together.
+func (i *CacheInvalidator) Begin(ctx context.Context) (context.Context, *InvalidationBatch) {
+	batch := &InvalidationBatch{invalidator: i}
+	return context.WithValue(ctx, invalidationBatchKey{}, batch), batch
+}
+
+// InvalidationBatch the invalidations of a transaction
+type InvalidationBatch struct {
+	mu          sync.Mutex
+	fns         []func(ctx context.Context)
+	invalidator *CacheInvalidator
+}
+
+func (b *InvalidationBatch) add(fn func(ctx context.Context)) {
+	b.mu.Lock()
+	b.fns = append(b.fns, fn)
+	b.mu.Unlock()
+}
+
+// Commit run the invalidations, must be called after the transaction is committed.
+// Nothing need to be done if the transaction is rolled back.
+func (b *InvalidationBatch) Commit(ctx context.Context) {
+	b.mu.Lock()
+	fns := b.fns
+	b.fns = nil
+	b.mu.Unlock()
+	if len(fns) == 0 {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	run := func() {
+		for _, fn := range fns {
+			fn(ctx)
+		}
+	}
+	run()
+	if !b.invalidator.schedule(run) {
+		// shutting down, the request waits for the delayed deletion instead
+		time.Sleep(b.invalidator.delay)
+		run()
+	}
+}
+
+// invalidate run fn after commit if ctx belongs to a transaction, otherwise run it right now
+func invalidate(ctx context.Context, fn func(ctx context.Context)) {
+	if batch, ok := ctx.Value(invalidationBatchKey{}).(*InvalidationBatch); ok {
+		batch.add(fn)
+		return
+	}
+	fn(ctx)
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+)
+
+func TestInvalidationBatchCommit(t *testing.T) {
+	tests := []struct {
+		name string
+		// waitFirst the invalidator is shutting down before the commit
+		waitFirst bool
+	}{
+		{name: "delayed in background"},
+		{name: "delayed in place after shutdown", waitFirst: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalidator, wait := NewCacheInvalidator(&cache.Config{DoubleDeleteDelay: 10 * time.Millisecond})
+			if tt.waitFirst {
+				wait()
+			}
+
+			var runs int32
+			ctx, batch := invalidator.Begin(context.Background())
+			invalidate(ctx, func(ctx context.Context) { atomic.AddInt32(&runs, 1) })
+			if got := atomic.LoadInt32(&runs); got != 0 {
+				t.Fatalf("runs before commit = %d, want 0", got)
+			}
+			batch.Commit(ctx)
+			wait()
+
+			if got := atomic.LoadInt32(&runs); got != 2 {
+				t.Errorf("runs = %d, want 2", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package repository
+
+//go:generate mockgen -source=follow_source_stat_repo.go -destination=../../internal/mocks/follow_source_stat_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_tableFollowSourceStatName = (&model.FollowSourceStatModel{}).TableName()
+	_aggregateFollowSourceSQL  = "SELECT follow_source AS source, " +
+		"SUM(CASE WHEN action = ? THEN 1 ELSE 0 END) AS follow_count, " +
+		"SUM(CASE WHEN action = ? THEN 1 ELSE 0 END) AS unfollow_count " +
+		"FROM %s WHERE created_at >= ? AND created_at < ? GROUP BY follow_source"
+	_batchUpsertFollowSourceStatSQL = "INSERT INTO %s (stat_date, source, follow_count, unfollow_count, created_at, updated_at) VALUES %s " +
+		"on duplicate key update follow_count = VALUES(follow_count), unfollow_count = VALUES(unfollow_count), updated_at = VALUES(updated_at)"
+)
+
+var _ FollowSourceStatRepo = (*followSourceStatRepo)(nil)
+
+// FollowSourceStatRepo define a repo interface
+type FollowSourceStatRepo interface {
+	// AggregateFollowSourceStat 从关系变更日志中汇总某一天的数据
+	AggregateFollowSourceStat(ctx context.Context, day time.Time) ([]*model.FollowSourceStatModel, error)
+	BatchUpsertFollowSourceStat(ctx context.Context, data []*model.FollowSourceStatModel) error
+	// GetFollowSourceStatList source 小于 0 时不过滤来源
+	GetFollowSourceStatList(ctx context.Context, startDate, endDate time.Time, source int) ([]*model.FollowSourceStatModel, error)
+}
+
+type followSourceStatRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewFollowSourceStat new a repository and return
+func NewFollowSourceStat(db *gorm.DB) FollowSourceStatRepo {
+	return &followSourceStatRepo{
+		db:     db,
+		tracer: otel.Tracer("followSourceStatRepo"),
+	}
+}
+
+// AggregateFollowSourceStat aggregate follows and unfollows per source of the day
+func (r *followSourceStatRepo) AggregateFollowSourceStat(ctx context.Context, day time.Time) ([]*model.FollowSourceStatModel, error) {
+	start, end := dayRange(day)
+
+	data := make([]*model.FollowSourceStatModel, 0)
+	_sql := fmt.Sprintf(_aggregateFollowSourceSQL, _tableRelationLogName)
+	err := r.db.WithContext(ctx).Raw(_sql, model.RelationLogActionFollow, model.RelationLogActionUnfollow, start, end).
+		Scan(&data).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] aggregate FollowSourceStat err")
+	}
+	for _, v := range data {
+		v.StatDate = start
+	}
+	return data, nil
+}
+
+// BatchUpsertFollowSourceStat create or overwrite items, so the rollup can be rerun
+func (r *followSourceStatRepo) BatchUpsertFollowSourceStat(ctx context.Context, data []*model.FollowSourceStatModel) error {
+	if len(data) == 0 {
+		return nil
+	}
+	curTime := time.Now()
+	placeholders := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*6)
+	for _, v := range data {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+		args = append(args, v.StatDate.Format("2006-01-02"), v.Source, v.FollowCount, v.UnfollowCount, curTime, curTime)
+	}
+	_sql := fmt.Sprintf(_batchUpsertFollowSourceStatSQL, _tableFollowSourceStatName, strings.Join(placeholders, ","))
+	err := r.db.WithContext(ctx).Exec(_sql, args...).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] batch upsert FollowSourceStat err")
+	}
+	return nil
+}
+
+// GetFollowSourceStatList get stats between the dates, both inclusive
+func (r *followSourceStatRepo) GetFollowSourceStatList(ctx context.Context, startDate, endDate time.Time, source int) ([]*model.FollowSourceStatModel, error) {
+	query := r.db.WithContext(ctx).Where("stat_date>=? AND stat_date<=?",
+		startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	if source >= 0 {
+		query = query.Where("source=?", source)
+	}
+
+	statList := make([]*model.FollowSourceStatModel, 0)
+	result := query.Order("stat_date asc, source asc").Find(&statList)
+	if err := result.Error; err != nil {
+		return nil, errors.Wrapf(err, "get follow source stat list err")
+	}
+
+	return statList, nil
+}
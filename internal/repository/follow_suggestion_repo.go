@@ -0,0 +1,110 @@
+package repository
+
+//go:generate mockgen -source=follow_suggestion_repo.go -destination=../../internal/mocks/follow_suggestion_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// FollowSuggestionSize 每个用户预计算的推荐数
+	FollowSuggestionSize = 100
+)
+
+var (
+	_tableUserBlockName = (&model.UserBlockModel{}).TableName()
+	// 二度关系: 我关注的人所关注的人, 排除自己、已关注的人以及拉黑关系
+	_getFollowSuggestionSQL = "SELECT b.followed_uid AS uid, COUNT(*) AS mutual_count FROM %[1]s a " +
+		"INNER JOIN %[1]s b ON b.user_id = a.followed_uid " +
+		"WHERE a.user_id = ? AND a.status = 1 AND b.status = 1 AND b.followed_uid <> ? " +
+		"AND NOT EXISTS (SELECT 1 FROM %[1]s c WHERE c.user_id = ? AND c.followed_uid = b.followed_uid AND c.status = 1) " +
+		"AND NOT EXISTS (SELECT 1 FROM %[2]s d WHERE d.user_id = ? AND d.blocked_uid = b.followed_uid AND d.status = 1) " +
+		"AND NOT EXISTS (SELECT 1 FROM %[2]s e WHERE e.user_id = b.followed_uid AND e.blocked_uid = ? AND e.status = 1) " +
+		"GROUP BY b.followed_uid ORDER BY mutual_count DESC LIMIT ?"
+)
+
+var _ FollowSuggestionRepo = (*followSuggestionRepo)(nil)
+
+// FollowSuggestionRepo define a repo interface
+type FollowSuggestionRepo interface {
+	// GetFollowSuggestions 读取推荐结果, 缓存不存在时实时计算
+	GetFollowSuggestions(ctx context.Context, userID int64) ([]*model.FollowSuggestion, error)
+	// RefreshFollowSuggestions 重新计算并写入缓存
+	RefreshFollowSuggestions(ctx context.Context, userID int64) ([]*model.FollowSuggestion, error)
+	// GetActiveUserIDs 获取最近有关注变化的用户, 用于预计算
+	GetActiveUserIDs(ctx context.Context, since time.Time, lastUserID int64, limit int) ([]int64, error)
+	// PurgeFollowSuggestions 删除推荐结果缓存, 下次读取时重新计算
+	PurgeFollowSuggestions(ctx context.Context, userID int64) error
+}
+
+type followSuggestionRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+	cache  cache.FollowSuggestionCache
+	// ttl 推荐结果缓存时间, 需要大于预计算任务的周期
+	ttl time.Duration
+}
+
+// NewFollowSuggestion new a repository and return
+func NewFollowSuggestion(db *gorm.DB, suggestionCache cache.FollowSuggestionCache, cacheCfg *cache.Config) FollowSuggestionRepo {
+	return &followSuggestionRepo{
+		db:     db,
+		tracer: otel.Tracer("followSuggestionRepo"),
+		cache:  suggestionCache,
+		ttl:    cacheCfg.TTL.FollowSuggestion,
+	}
+}
+
+// GetFollowSuggestions get suggestions from cache first
+func (r *followSuggestionRepo) GetFollowSuggestions(ctx context.Context, userID int64) ([]*model.FollowSuggestion, error) {
+	data, err := r.cache.GetFollowSuggestionCache(ctx, userID)
+	if err == nil && data != nil {
+		return data, nil
+	}
+
+	return r.RefreshFollowSuggestions(ctx, userID)
+}
+
+// RefreshFollowSuggestions compute suggestions by friends-of-friends and cache it
+func (r *followSuggestionRepo) RefreshFollowSuggestions(ctx context.Context, userID int64) ([]*model.FollowSuggestion, error) {
+	data := make([]*model.FollowSuggestion, 0)
+	_sql := fmt.Sprintf(_getFollowSuggestionSQL, _tableUserFollowingName, _tableUserBlockName)
+	err := r.db.WithContext(ctx).Raw(_sql, userID, userID, userID, userID, userID, FollowSuggestionSize).Scan(&data).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "get follow suggestions err")
+	}
+
+	_ = r.cache.SetFollowSuggestionCache(ctx, userID, data, r.ttl)
+	return data, nil
+}
+
+// GetActiveUserIDs get users who changed their followings since the given time,
+// updated_at is NULL if the edge is not updated since it was inserted, then created_at is used
+func (r *followSuggestionRepo) GetActiveUserIDs(ctx context.Context, since time.Time, lastUserID int64, limit int) ([]int64, error) {
+	userIDs := make([]int64, 0)
+	err := r.db.WithContext(ctx).Model(&model.UserFollowingModel{}).
+		Distinct("user_id").
+		Where("user_id>? AND (updated_at>=? OR (updated_at IS NULL AND created_at>=?))", lastUserID, since, since).
+		Order("user_id asc").
+		Limit(limit).Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "get active user ids err")
+	}
+
+	return userIDs, nil
+}
+
+// PurgeFollowSuggestions delete the cached suggestions
+func (r *followSuggestionRepo) PurgeFollowSuggestions(ctx context.Context, userID int64) error {
+	return r.cache.DelFollowSuggestionCache(ctx, userID)
+}
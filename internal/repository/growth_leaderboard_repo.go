@@ -0,0 +1,97 @@
+package repository
+
+//go:generate mockgen -source=growth_leaderboard_repo.go -destination=../../internal/mocks/growth_leaderboard_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_getFollowerGrowthSQL = "SELECT user_id AS uid, COUNT(*) AS growth FROM %s " +
+		"WHERE created_at >= ? AND created_at < ? AND status = 1 GROUP BY user_id"
+)
+
+var _ GrowthLeaderboardRepo = (*growthLeaderboardRepo)(nil)
+
+// GrowthLeaderboardRepo define a repo interface
+type GrowthLeaderboardRepo interface {
+	// IncrFollowerGrowth 关注时 delta 为 1, 取关时为 -1
+	IncrFollowerGrowth(ctx context.Context, uid, delta int64) error
+	GetTopGrowingAccounts(ctx context.Context, window time.Duration, limit int) ([]*model.AccountGrowth, error)
+	// RebuildGrowthLeaderboard 根据 user_follower.created_at 重建窗口内已结束的桶, force 为 false 时跳过已存在的桶
+	RebuildGrowthLeaderboard(ctx context.Context, window time.Duration, force bool) (rebuilt int, err error)
+}
+
+type growthLeaderboardRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+	cache  cache.GrowthLeaderboardCache
+}
+
+// NewGrowthLeaderboard new a repository and return
+func NewGrowthLeaderboard(db *gorm.DB, cache cache.GrowthLeaderboardCache) GrowthLeaderboardRepo {
+	return &growthLeaderboardRepo{
+		db:     db,
+		tracer: otel.Tracer("growthLeaderboardRepo"),
+		cache:  cache,
+	}
+}
+
+// IncrFollowerGrowth incr the growth of current bucket
+func (r *growthLeaderboardRepo) IncrFollowerGrowth(ctx context.Context, uid, delta int64) error {
+	return r.cache.IncrGrowthCache(ctx, uid, delta, time.Now())
+}
+
+// GetTopGrowingAccounts get the fastest growing accounts in the window
+func (r *growthLeaderboardRepo) GetTopGrowingAccounts(ctx context.Context, window time.Duration, limit int) ([]*model.AccountGrowth, error) {
+	ret, err := r.cache.GetTopGrowthCache(ctx, window, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get top growing accounts err")
+	}
+	return ret, nil
+}
+
+// RebuildGrowthLeaderboard rebuild buckets from db, unfollows can not be recovered
+// the current bucket is skipped, it is being incremented by the follows, overwriting it may lose or double count them
+func (r *growthLeaderboardRepo) RebuildGrowthLeaderboard(ctx context.Context, window time.Duration, force bool) (int, error) {
+	rebuilt := 0
+	now := time.Now()
+	_sql := fmt.Sprintf(_getFollowerGrowthSQL, _tableUserFollowerName)
+	current := now.Truncate(cache.GrowthBucketSize)
+	for bucket := current.Add(-cache.GrowthBucketSize); now.Sub(bucket) < window; bucket = bucket.Add(-cache.GrowthBucketSize) {
+		if !force {
+			exist, err := r.cache.ExistsGrowthBucketCache(ctx, bucket)
+			if err != nil {
+				return rebuilt, err
+			}
+			if exist {
+				continue
+			}
+		}
+
+		data := make([]*model.AccountGrowth, 0)
+		err := r.db.WithContext(ctx).Raw(_sql, bucket, bucket.Add(cache.GrowthBucketSize)).Scan(&data).Error
+		if err != nil {
+			return rebuilt, errors.Wrap(err, "[repo] get follower growth err")
+		}
+		growth := make(map[int64]int64, len(data))
+		for _, v := range data {
+			growth[v.UID] = v.Growth
+		}
+		if err := r.cache.SetGrowthBucketCache(ctx, bucket, growth); err != nil {
+			return rebuilt, err
+		}
+		rebuilt++
+	}
+	return rebuilt, nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+//go:generate mockgen -source=relation_group_member_repo.go -destination=../../internal/mocks/relation_group_member_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_tableRelationGroupMemberName   = (&model.RelationGroupMemberModel{}).TableName()
+	_batchInsertRelationGroupMember = "INSERT INTO %s (group_id, user_id, followed_uid, status, created_at, updated_at) VALUES %s " +
+		"on duplicate key update status = VALUES(status), updated_at = VALUES(updated_at)"
+)
+
+var _ RelationGroupMemberRepo = (*relationGroupMemberRepo)(nil)
+
+// RelationGroupMemberRepo define a repo interface
+type RelationGroupMemberRepo interface {
+	BatchCreateGroupMember(ctx context.Context, db *gorm.DB, groupID, userID int64, followedUIDs []int64) error
+	BatchDeleteGroupMember(ctx context.Context, db *gorm.DB, groupID int64, followedUIDs []int64) error
+	// 删除分组下的所有成员
+	DeleteGroupMemberByGroup(ctx context.Context, db *gorm.DB, groupID int64) error
+	// 将关注关系从所有分组中移除, 取消关注时使用
+	DeleteGroupMemberByRelation(ctx context.Context, db *gorm.DB, userID, followedUID int64) error
+	// 获取分组成员列表
+	GetGroupMemberList(ctx context.Context, groupID, lastID int64, limit int) ([]*model.RelationGroupMemberModel, error)
+}
+
+type relationGroupMemberRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewRelationGroupMember new a repository and return
+func NewRelationGroupMember(db *gorm.DB) RelationGroupMemberRepo {
+	return &relationGroupMemberRepo{
+		db:     db,
+		tracer: otel.Tracer("relationGroupMemberRepo"),
+	}
+}
+
+// BatchCreateGroupMember create items, removed members will be restored
+func (r *relationGroupMemberRepo) BatchCreateGroupMember(ctx context.Context, db *gorm.DB, groupID, userID int64, followedUIDs []int64) error {
+	if len(followedUIDs) == 0 {
+		return nil
+	}
+	curTime := time.Now()
+	placeholders := make([]string, 0, len(followedUIDs))
+	args := make([]interface{}, 0, len(followedUIDs)*6)
+	for _, uid := range followedUIDs {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+		args = append(args, groupID, userID, uid, 1, curTime, curTime)
+	}
+	_sql := fmt.Sprintf(_batchInsertRelationGroupMember, _tableRelationGroupMemberName, strings.Join(placeholders, ","))
+	err := db.WithContext(ctx).Exec(_sql, args...).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] batch create RelationGroupMember err")
+	}
+	return nil
+}
+
+// BatchDeleteGroupMember soft delete items
+func (r *relationGroupMemberRepo) BatchDeleteGroupMember(ctx context.Context, db *gorm.DB, groupID int64, followedUIDs []int64) error {
+	if len(followedUIDs) == 0 {
+		return nil
+	}
+	err := db.WithContext(ctx).Model(&model.RelationGroupMemberModel{}).
+		Where("group_id=? AND followed_uid in (?) AND status=1", groupID, followedUIDs).
+		Updates(map[string]interface{}{"status": 0, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] batch delete RelationGroupMember err")
+	}
+	return nil
+}
+
+// DeleteGroupMemberByGroup soft delete all members of a group
+func (r *relationGroupMemberRepo) DeleteGroupMemberByGroup(ctx context.Context, db *gorm.DB, groupID int64) error {
+	err := db.WithContext(ctx).Model(&model.RelationGroupMemberModel{}).
+		Where("group_id=? AND status=1", groupID).
+		Updates(map[string]interface{}{"status": 0, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] delete RelationGroupMember by group err")
+	}
+	return nil
+}
+
+// DeleteGroupMemberByRelation soft delete the relation from all groups
+func (r *relationGroupMemberRepo) DeleteGroupMemberByRelation(ctx context.Context, db *gorm.DB, userID, followedUID int64) error {
+	err := db.WithContext(ctx).Model(&model.RelationGroupMemberModel{}).
+		Where("user_id=? AND followed_uid=? AND status=1", userID, followedUID).
+		Updates(map[string]interface{}{"status": 0, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] delete RelationGroupMember by relation err")
+	}
+	return nil
+}
+
+// GetGroupMemberList 获取分组成员列表
+func (r *relationGroupMemberRepo) GetGroupMemberList(ctx context.Context, groupID, lastID int64, limit int) ([]*model.RelationGroupMemberModel, error) {
+	memberList := make([]*model.RelationGroupMemberModel, 0)
+	result := r.db.WithContext(ctx).Where("group_id=? AND id<=? and status=1", groupID, lastID).
+		Order("id desc").
+		Limit(limit).Find(&memberList)
+
+	if err := result.Error; err != nil {
+		return nil, errors.Wrapf(err, "get relation group member list err")
+	}
+
+	return memberList, nil
+}
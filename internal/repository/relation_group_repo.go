@@ -0,0 +1,99 @@
+package repository
+
+//go:generate mockgen -source=relation_group_repo.go -destination=../../internal/mocks/relation_group_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var _ RelationGroupRepo = (*relationGroupRepo)(nil)
+
+// RelationGroupRepo define a repo interface
+type RelationGroupRepo interface {
+	CreateRelationGroup(ctx context.Context, data *model.RelationGroupModel) (id int64, err error)
+	UpdateRelationGroupName(ctx context.Context, userID, groupID int64, name string) error
+	DeleteRelationGroup(ctx context.Context, db *gorm.DB, userID, groupID int64) error
+	GetRelationGroup(ctx context.Context, userID, groupID int64) (ret *model.RelationGroupModel, err error)
+	// 获取用户的分组列表
+	GetRelationGroupList(ctx context.Context, userID int64) ([]*model.RelationGroupModel, error)
+}
+
+type relationGroupRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewRelationGroup new a repository and return
+func NewRelationGroup(db *gorm.DB) RelationGroupRepo {
+	return &relationGroupRepo{
+		db:     db,
+		tracer: otel.Tracer("relationGroupRepo"),
+	}
+}
+
+// CreateRelationGroup create a item
+func (r *relationGroupRepo) CreateRelationGroup(ctx context.Context, data *model.RelationGroupModel) (id int64, err error) {
+	err = r.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] create RelationGroup err")
+	}
+
+	return data.ID, nil
+}
+
+// UpdateRelationGroupName update item
+func (r *relationGroupRepo) UpdateRelationGroupName(ctx context.Context, userID, groupID int64, name string) error {
+	err := r.db.WithContext(ctx).Model(&model.RelationGroupModel{}).
+		Where("id=? AND user_id=? AND status=1", groupID, userID).
+		Updates(map[string]interface{}{"name": name, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] update RelationGroup err")
+	}
+	return nil
+}
+
+// DeleteRelationGroup soft delete item
+func (r *relationGroupRepo) DeleteRelationGroup(ctx context.Context, db *gorm.DB, userID, groupID int64) error {
+	err := db.WithContext(ctx).Model(&model.RelationGroupModel{}).
+		Where("id=? AND user_id=?", groupID, userID).
+		Updates(map[string]interface{}{"status": 0, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] delete RelationGroup err")
+	}
+	return nil
+}
+
+// GetRelationGroup get a record, return nil if not exist
+func (r *relationGroupRepo) GetRelationGroup(ctx context.Context, userID, groupID int64) (ret *model.RelationGroupModel, err error) {
+	data := make([]*model.RelationGroupModel, 0)
+	err = r.db.WithContext(ctx).Where("id=? AND user_id=? AND status=1", groupID, userID).
+		Limit(1).Find(&data).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get RelationGroup err")
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data[0], nil
+}
+
+// GetRelationGroupList 获取用户的分组列表
+func (r *relationGroupRepo) GetRelationGroupList(ctx context.Context, userID int64) ([]*model.RelationGroupModel, error) {
+	groupList := make([]*model.RelationGroupModel, 0)
+	result := r.db.WithContext(ctx).Where("user_id=? AND status=1", userID).
+		Order("id asc").Find(&groupList)
+
+	if err := result.Error; err != nil {
+		return nil, errors.Wrapf(err, "get relation group list err")
+	}
+
+	return groupList, nil
+}
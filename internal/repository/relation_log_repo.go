@@ -0,0 +1,105 @@
+package repository
+
+//go:generate mockgen -source=relation_log_repo.go -destination=../../internal/mocks/relation_log_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_tableRelationLogName = (&model.RelationLogModel{}).TableName()
+	_deleteRelationLogSQL = "DELETE FROM %s WHERE created_at < ? LIMIT ?"
+)
+
+var _ RelationLogRepo = (*relationLogRepo)(nil)
+
+// RelationLogFilter 查询条件, 零值表示不过滤
+type RelationLogFilter struct {
+	UserID    int64
+	TargetUID int64
+	Action    string
+	Source    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// RelationLogRepo define a repo interface
+type RelationLogRepo interface {
+	CreateRelationLog(ctx context.Context, db *gorm.DB, data *model.RelationLogModel) (id int64, err error)
+	GetRelationLogList(ctx context.Context, filter *RelationLogFilter, lastID int64, limit int) ([]*model.RelationLogModel, error)
+	// 删除指定时间之前的日志, 返回删除的行数
+	DeleteRelationLogBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+type relationLogRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewRelationLog new a repository and return
+func NewRelationLog(db *gorm.DB) RelationLogRepo {
+	return &relationLogRepo{
+		db:     db,
+		tracer: otel.Tracer("relationLogRepo"),
+	}
+}
+
+// CreateRelationLog create a item
+func (r *relationLogRepo) CreateRelationLog(ctx context.Context, db *gorm.DB, data *model.RelationLogModel) (id int64, err error) {
+	err = db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] create RelationLog err")
+	}
+
+	return data.ID, nil
+}
+
+// GetRelationLogList 获取关系变更日志
+func (r *relationLogRepo) GetRelationLogList(ctx context.Context, filter *RelationLogFilter, lastID int64, limit int) ([]*model.RelationLogModel, error) {
+	query := r.db.WithContext(ctx).Where("id<=?", lastID)
+	if filter.UserID > 0 {
+		query = query.Where("user_id=?", filter.UserID)
+	}
+	if filter.TargetUID > 0 {
+		query = query.Where("target_uid=?", filter.TargetUID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action=?", filter.Action)
+	}
+	if filter.Source != "" {
+		query = query.Where("source=?", filter.Source)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("created_at>=?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("created_at<?", filter.EndTime)
+	}
+
+	logList := make([]*model.RelationLogModel, 0)
+	result := query.Order("id desc").Limit(limit).Find(&logList)
+	if err := result.Error; err != nil {
+		return nil, errors.Wrapf(err, "get relation log list err")
+	}
+
+	return logList, nil
+}
+
+// DeleteRelationLogBefore delete the expired logs
+func (r *relationLogRepo) DeleteRelationLogBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	_sql := fmt.Sprintf(_deleteRelationLogSQL, _tableRelationLogName)
+	result := r.db.WithContext(ctx).Exec(_sql, before, limit)
+	if err := result.Error; err != nil {
+		return 0, errors.Wrap(err, "[repo] delete RelationLog err")
+	}
+	return result.RowsAffected, nil
+}
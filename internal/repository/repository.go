@@ -6,4 +6,4 @@ import (
 )
 
 // ProviderSet is repo providers.
-var ProviderSet = wire.NewSet(model.GetDB, NewUserFollower, NewUserFollowing)
+var ProviderSet = wire.NewSet(model.GetDB, NewUserFollower, NewUserFollowing, NewFollowSuggestion, NewRelationGroup, NewRelationGroupMember, NewUserCloseFriend, NewRelationLog, NewFollowSourceStat, NewGrowthLeaderboard, NewUserRelationSnapshot, NewUserRelationMerge, NewUserBlock, NewCacheInvalidator)
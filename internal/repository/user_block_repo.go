@@ -0,0 +1,48 @@
+package repository
+
+//go:generate mockgen -source=user_block_repo.go -destination=../../internal/mocks/user_block_repo_mock.go  -package mocks
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var _ UserBlockRepo = (*userBlockRepo)(nil)
+
+// UserBlockRepo define a repo interface
+type UserBlockRepo interface {
+	// IsBlockedBetween 两个用户中是否有一方拉黑了另一方
+	IsBlockedBetween(ctx context.Context, userID, otherUID int64) (bool, error)
+}
+
+type userBlockRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewUserBlock new a repository and return
+func NewUserBlock(db *gorm.DB) UserBlockRepo {
+	return &userBlockRepo{
+		db:     db,
+		tracer: otel.Tracer("userBlockRepo"),
+	}
+}
+
+// IsBlockedBetween check both directions, it is only used by the writes so the cache is not needed
+func (r *userBlockRepo) IsBlockedBetween(ctx context.Context, userID, otherUID int64) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.UserBlockModel{}).
+		Where("((user_id=? AND blocked_uid=?) OR (user_id=? AND blocked_uid=?)) AND status=1",
+			userID, otherUID, otherUID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, errors.Wrap(err, "[repo] check UserBlock err")
+	}
+	return count > 0, nil
+}
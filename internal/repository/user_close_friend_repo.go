@@ -0,0 +1,168 @@
+package repository
+
+//go:generate mockgen -source=user_close_friend_repo.go -destination=../../internal/mocks/user_close_friend_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_tableUserCloseFriendName = (&model.UserCloseFriendModel{}).TableName()
+	_insertUserCloseFriendSQL = "INSERT INTO %s SET user_id = ?, friend_uid = ?, created_at = ?, status = ? on duplicate key update status = ?, updated_at = ?"
+)
+
+var _ UserCloseFriendRepo = (*userCloseFriendRepo)(nil)
+
+// UserCloseFriendRepo define a repo interface
+type UserCloseFriendRepo interface {
+	CreateUserCloseFriend(ctx context.Context, db *gorm.DB, data *model.UserCloseFriendModel) (id int64, err error)
+	UpdateUserCloseFriendStatus(ctx context.Context, db *gorm.DB, userID, friendUID int64, status int) error
+	// 批量判断是否为密友, 只返回有效的记录
+	BatchGetUserCloseFriend(ctx context.Context, userID int64, friendUIDs []int64) ([]*model.UserCloseFriendModel, error)
+	// 获取密友列表
+	GetCloseFriendList(ctx context.Context, userID, lastID int64, limit int) ([]*model.UserCloseFriendModel, error)
+	// PurgeUserCloseFriendCache 删除用户最近的密友关系缓存, 返回删除的缓存数
+	PurgeUserCloseFriendCache(ctx context.Context, userID int64) (int, error)
+}
+
+type userCloseFriendRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+	cache  cache.UserCloseFriendCache
+	ttl    time.Duration
+}
+
+// NewUserCloseFriend new a repository and return
+func NewUserCloseFriend(db *gorm.DB, closeFriendCache cache.UserCloseFriendCache, cacheCfg *cache.Config) UserCloseFriendRepo {
+	return &userCloseFriendRepo{
+		db:     db,
+		tracer: otel.Tracer("userCloseFriendRepo"),
+		cache:  closeFriendCache,
+		ttl:    cacheCfg.TTL.UserCloseFriend,
+	}
+}
+
+// CreateUserCloseFriend create a item
+func (r *userCloseFriendRepo) CreateUserCloseFriend(ctx context.Context, db *gorm.DB, data *model.UserCloseFriendModel) (id int64, err error) {
+	_sql := fmt.Sprintf(_insertUserCloseFriendSQL, _tableUserCloseFriendName)
+	err = db.WithContext(ctx).Exec(_sql,
+		data.UserID, data.FriendUID,
+		data.CreatedAt, data.Status,
+		data.Status, data.UpdatedAt,
+	).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] create UserCloseFriend err")
+	}
+
+	// delete cache after commit
+	invalidate(ctx, func(ctx context.Context) {
+		_ = r.cache.DelUserCloseFriendCache(ctx, data.UserID, data.FriendUID)
+	})
+	return data.ID, nil
+}
+
+// UpdateUserCloseFriendStatus update item
+func (r *userCloseFriendRepo) UpdateUserCloseFriendStatus(ctx context.Context, db *gorm.DB, userID, friendUID int64, status int) error {
+	err := db.WithContext(ctx).Model(&model.UserCloseFriendModel{}).Where("user_id=? and friend_uid=?", userID, friendUID).
+		Updates(map[string]interface{}{"status": status, "updated_at": time.Now()}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] update UserCloseFriend err")
+	}
+
+	// delete cache after commit
+	invalidate(ctx, func(ctx context.Context) {
+		_ = r.cache.DelUserCloseFriendCache(ctx, userID, friendUID)
+	})
+	return nil
+}
+
+// BatchGetUserCloseFriend read the per-pair cache first, non-members are cached as empty records too
+func (r *userCloseFriendRepo) BatchGetUserCloseFriend(ctx context.Context, userID int64, friendUIDs []int64) ([]*model.UserCloseFriendModel, error) {
+	cached, err := r.cache.MultiGetUserCloseFriendCache(ctx, userID, friendUIDs)
+	if err != nil {
+		log.WithContext(ctx).Warnf("multi get user close friend from cache err: %+v", err)
+		cached = make(map[int64]*model.UserCloseFriendModel)
+	}
+
+	ret := make([]*model.UserCloseFriendModel, 0, len(friendUIDs))
+	missUIDs := make([]int64, 0)
+	for _, uid := range friendUIDs {
+		item, ok := cached[uid]
+		if !ok {
+			missUIDs = append(missUIDs, uid)
+			continue
+		}
+		if item.Status == 1 {
+			ret = append(ret, item)
+		}
+	}
+	if len(missUIDs) == 0 {
+		return ret, nil
+	}
+
+	missList := make([]*model.UserCloseFriendModel, 0)
+	err = r.db.WithContext(ctx).Where("user_id=? AND friend_uid in (?)", userID, missUIDs).Find(&missList).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "batch get user close friend err")
+	}
+
+	found := make(map[int64]*model.UserCloseFriendModel, len(missList))
+	for _, v := range missList {
+		found[v.FriendUID] = v
+	}
+	for _, uid := range missUIDs {
+		item, ok := found[uid]
+		if !ok {
+			item = &model.UserCloseFriendModel{UserID: userID, FriendUID: uid}
+		}
+		_ = r.cache.SetUserCloseFriendCache(ctx, userID, uid, item, r.ttl)
+		if item.Status == 1 {
+			ret = append(ret, item)
+		}
+	}
+
+	return ret, nil
+}
+
+// GetCloseFriendList 获取密友列表
+func (r *userCloseFriendRepo) GetCloseFriendList(ctx context.Context, userID, lastID int64, limit int) ([]*model.UserCloseFriendModel, error) {
+	closeFriendList := make([]*model.UserCloseFriendModel, 0)
+	result := r.db.WithContext(ctx).Where("user_id=? AND id<=? and status=1", userID, lastID).
+		Order("id desc").
+		Limit(limit).Find(&closeFriendList)
+
+	if err := result.Error; err != nil {
+		return nil, errors.Wrapf(err, "get user close friend list err")
+	}
+
+	return closeFriendList, nil
+}
+
+// PurgeUserCloseFriendCache delete the caches of the latest close friends, non-members are included
+func (r *userCloseFriendRepo) PurgeUserCloseFriendCache(ctx context.Context, userID int64) (int, error) {
+	friendUIDs := make([]int64, 0)
+	err := r.db.WithContext(ctx).Model(&model.UserCloseFriendModel{}).Where("user_id=?", userID).
+		Order("id desc").
+		Limit(maxWarmCacheSize).Pluck("friend_uid", &friendUIDs).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, "get user close friend for purge cache err")
+	}
+
+	for _, uid := range friendUIDs {
+		if err := r.cache.DelUserCloseFriendCache(ctx, userID, uid); err != nil {
+			return 0, err
+		}
+	}
+	return len(friendUIDs), nil
+}
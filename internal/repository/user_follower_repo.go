@@ -5,11 +5,14 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/go-eagle/eagle/pkg/log"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 
 	"github.com/go-microservice/relation-service/internal/cache"
@@ -17,9 +20,11 @@ import (
 )
 
 var (
-	_tableUserFollowerName   = (&model.UserFollowerModel{}).TableName()
-	_insertUserFollowerSQL   = "INSERT INTO %s SET user_id = ?, follower_uid =?, created_at = ?, status = ? on duplicate key update status = ?, updated_at = ?"
-	_getUserFollowerSQL      = "SELECT * FROM %s WHERE id = ?"
+	_tableUserFollowerName      = (&model.UserFollowerModel{}).TableName()
+	_insertUserFollowerSQL      = "INSERT INTO %s SET user_id = ?, follower_uid =?, created_at = ?, updated_at = ?, status = ? on duplicate key update status = ?, updated_at = ?"
+	_batchUpsertUserFollowerSQL = "INSERT INTO %s (user_id, follower_uid, status, created_at, updated_at) VALUES %s " +
+		"on duplicate key update status = VALUES(status), updated_at = VALUES(updated_at)"
+	_getUserFollowerSQL      = "SELECT * FROM %s WHERE user_id = ? and follower_uid = ?"
 	_batchGetUserFollowerSQL = "SELECT * FROM %s WHERE id IN (%s)"
 )
 
@@ -30,22 +35,43 @@ type UserFollowerRepo interface {
 	CreateUserFollower(ctx context.Context, db *gorm.DB, data *model.UserFollowerModel) (id int64, err error)
 	UpdateUserFollowerStatus(ctx context.Context, db *gorm.DB, userID, followerUID int64, status int) error
 	GetUserFollower(ctx context.Context, userID, followedUID int64) (ret *model.UserFollowerModel, err error)
+	GetUserFollowerWithoutCache(ctx context.Context, userID, followerUID int64) (ret *model.UserFollowerModel, err error)
 	// 获取粉丝用户列表
 	GetFollowerUserList(ctx context.Context, userID, lastID int64, limit int) ([]*model.UserFollowerModel, error)
+	// WarmUserFollowerCache 预热用户最近的粉丝关系缓存和粉丝集合, 返回加载的关系数
+	WarmUserFollowerCache(ctx context.Context, userID int64) (int, error)
+	// BatchUpsertUserFollower 批量写入粉丝关系, 用于导入
+	BatchUpsertUserFollower(ctx context.Context, db *gorm.DB, data []*model.UserFollowerModel) error
+	// PurgeUserFollowerCache 删除用户最近的粉丝关系缓存和粉丝集合, 返回删除的关系缓存数
+	PurgeUserFollowerCache(ctx context.Context, userID int64) (int, error)
+	// ScanUserFollowerByUser 按主键顺序获取用户 lastID 之后的有效粉丝关系, 不走缓存, 用于批量处理用户的所有粉丝
+	ScanUserFollowerByUser(ctx context.Context, db *gorm.DB, userID, lastID int64, limit int) ([]*model.UserFollowerModel, error)
 }
 
 type userFollowerRepo struct {
-	db     *gorm.DB
-	tracer trace.Tracer
-	cache  cache.UserFollowerCache
+	db         *gorm.DB
+	tracer     trace.Tracer
+	cache      cache.UserFollowerCache
+	setCache   cache.RelationSetCache
+	localCache cache.LocalCache
+	breaker    *cache.Breaker
+	sf         singleflight.Group
+	ttl        time.Duration
+	setTTL     time.Duration
 }
 
 // NewUserFollower new a repository and return
-func NewUserFollower(db *gorm.DB, cache cache.UserFollowerCache) UserFollowerRepo {
+func NewUserFollower(db *gorm.DB, userFollowerCache cache.UserFollowerCache, setCache cache.RelationSetCache,
+	breaker *cache.Breaker, cacheCfg *cache.Config) UserFollowerRepo {
 	return &userFollowerRepo{
-		db:     db,
-		tracer: otel.Tracer("userFollowerRepo"),
-		cache:  cache,
+		db:         db,
+		tracer:     otel.Tracer("userFollowerRepo"),
+		cache:      userFollowerCache,
+		setCache:   setCache,
+		localCache: cache.NewLocalCache("user_follower", cacheCfg),
+		breaker:    breaker,
+		ttl:        cacheCfg.TTL.UserFollower,
+		setTTL:     cacheCfg.TTL.RelationSet,
 	}
 }
 
@@ -54,16 +80,51 @@ func (r *userFollowerRepo) CreateUserFollower(ctx context.Context, db *gorm.DB,
 	_sql := fmt.Sprintf(_insertUserFollowerSQL, _tableUserFollowerName)
 	err = db.WithContext(ctx).Exec(_sql,
 		data.UserID, data.FollowerUID,
-		data.CreatedAt, data.Status,
+		data.CreatedAt, data.UpdatedAt, data.Status,
 		data.Status, data.UpdatedAt,
 	).Error
 	if err != nil {
 		return 0, errors.Wrap(err, "[repo] create UserFollower err")
 	}
 
+	// delete cache after commit
+	r.invalidate(ctx, data.UserID, data.FollowerUID)
 	return data.ID, nil
 }
 
+// BatchUpsertUserFollower create items in one statement, the caches are deleted after commit
+func (r *userFollowerRepo) BatchUpsertUserFollower(ctx context.Context, db *gorm.DB, data []*model.UserFollowerModel) error {
+	if len(data) == 0 {
+		return nil
+	}
+	placeholders := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*5)
+	for _, v := range data {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, v.UserID, v.FollowerUID, v.Status, v.CreatedAt, v.UpdatedAt)
+	}
+	_sql := fmt.Sprintf(_batchUpsertUserFollowerSQL, _tableUserFollowerName, strings.Join(placeholders, ","))
+	err := db.WithContext(ctx).Exec(_sql, args...).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] batch upsert UserFollower err")
+	}
+
+	// delete cache after commit, the set of a user is deleted only once
+	invalidate(ctx, func(ctx context.Context) {
+		userIDs := make(map[int64]struct{})
+		for _, v := range data {
+			_ = r.cache.DelUserFollowerCache(ctx, v.UserID, v.FollowerUID)
+			r.localCache.Del(fmt.Sprintf(cache.PrefixUserFollowerCacheKey, v.UserID, v.FollowerUID))
+			userIDs[v.UserID] = struct{}{}
+		}
+		for userID := range userIDs {
+			_ = r.setCache.DelFollowerSetCache(ctx, userID)
+			r.localCache.Del(userFollowerListLocalKey(userID))
+		}
+	})
+	return nil
+}
+
 // UpdateUserFollower update item
 func (r *userFollowerRepo) UpdateUserFollowerStatus(ctx context.Context, db *gorm.DB, userID, followerUID int64, status int) error {
 	userFans := model.UserFollowerModel{}
@@ -72,46 +133,180 @@ func (r *userFollowerRepo) UpdateUserFollowerStatus(ctx context.Context, db *gor
 	if err != nil {
 		return err
 	}
-	// delete cache
-	_ = r.cache.DelUserFollowerCache(ctx, userID, followerUID)
+	// delete cache after commit
+	r.invalidate(ctx, userID, followerUID)
 	return nil
 }
 
 // GetUserFollower get a record
 func (r *userFollowerRepo) GetUserFollower(ctx context.Context, userID, followedUID int64) (ret *model.UserFollowerModel, err error) {
-	// read cache
-	item, err := r.cache.GetUserFollowerCache(ctx, userID, followedUID)
-	if err != nil {
-		return nil, err
+	localKey := fmt.Sprintf(cache.PrefixUserFollowerCacheKey, userID, followedUID)
+	if val, ok := r.localCache.Get(localKey); ok {
+		return val.(*model.UserFollowerModel), nil
 	}
+
+	// read cache, fall back to DB if redis is unavailable
+	item, cacheErr := r.cache.GetUserFollowerCache(ctx, userID, followedUID)
 	if item != nil {
+		r.localCache.Set(localKey, item)
 		return item, nil
 	}
-	data := new(model.UserFollowerModel)
-	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_getUserFollowerSQL, _tableUserFollowerName), userID, followedUID).Scan(&data).Error
-	if err != nil {
-		return
-	}
 
-	if data.ID > 0 {
-		err = r.cache.SetUserFollowerCache(ctx, userID, followedUID, data, 5*time.Minute)
+	// only one request of the same key loads from DB, the others wait for its result
+	val, err, _ := r.sf.Do(localKey, func() (interface{}, error) {
+		// not canceled by the first caller, the result is shared
+		ctx := context.WithoutCancel(ctx)
+		data := new(model.UserFollowerModel)
+		err := loadFromDB(ctx, r.breaker, cacheErr, func() error {
+			return r.db.WithContext(ctx).Raw(fmt.Sprintf(_getUserFollowerSQL, _tableUserFollowerName), userID, followedUID).Scan(&data).Error
+		})
 		if err != nil {
 			return nil, err
 		}
+		if data.ID > 0 && !cacheUnavailable(cacheErr) {
+			// the result is still returned if it fails to write cache
+			err = r.cache.SetUserFollowerCache(ctx, userID, followedUID, data, r.ttl)
+			if err != nil {
+				log.WithContext(ctx).Warnf("set user follower cache err: %+v", err)
+			}
+		}
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := val.(*model.UserFollowerModel)
+	r.localCache.Set(localKey, data)
+	return data, nil
+}
+
+// GetUserFollowerWithoutCache get a record from DB
+func (r *userFollowerRepo) GetUserFollowerWithoutCache(ctx context.Context, userID, followerUID int64) (ret *model.UserFollowerModel, err error) {
+	data := new(model.UserFollowerModel)
+	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_getUserFollowerSQL, _tableUserFollowerName), userID, followerUID).Scan(&data).Error
+	if err != nil {
+		return
 	}
 	return data, nil
 }
 
+// ScanUserFollowerByUser scan the active followers of a user by id
+func (r *userFollowerRepo) ScanUserFollowerByUser(ctx context.Context, db *gorm.DB, userID, lastID int64, limit int) ([]*model.UserFollowerModel, error) {
+	ret := make([]*model.UserFollowerModel, 0, limit)
+	err := db.WithContext(ctx).Where("user_id=? AND id>? AND status=1", userID, lastID).
+		Order("id asc").Limit(limit).Find(&ret).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] scan UserFollower by user err")
+	}
+	return ret, nil
+}
+
 // GetFollowingUserList 获取粉丝用户列表
 func (r *userFollowerRepo) GetFollowerUserList(ctx context.Context, userID, lastID int64, limit int) ([]*model.UserFollowerModel, error) {
+	// the first pages of a celebrity's followers are the hottest
+	localKey := userFollowerListLocalKey(userID)
+	page := fmt.Sprintf("%d_%d", lastID, limit)
+	val, pages, ok := cache.GetLocalPage(r.localCache, localKey, page)
+	if ok {
+		return val.([]*model.UserFollowerModel), nil
+	}
+
+	val, err, _ := r.sf.Do(localKey+":"+page, func() (interface{}, error) {
+		ctx := context.WithoutCancel(ctx)
+		userFollowerList := make([]*model.UserFollowerModel, 0)
+		result := r.db.WithContext(ctx).Where("user_id=? AND id<=? and status=1", userID, lastID).
+			Order("id desc").
+			Limit(limit).Find(&userFollowerList)
+
+		if err := result.Error; err != nil {
+			return nil, errors.Wrapf(err, "get user follower list err")
+		}
+		return userFollowerList, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userFollowerList := val.([]*model.UserFollowerModel)
+	cache.SetLocalPage(r.localCache, localKey, pages, page, userFollowerList)
+	return userFollowerList, nil
+}
+
+// WarmUserFollowerCache load the latest followers into cache, the unfollowed edges are loaded too
+// to overwrite the stale values.
+func (r *userFollowerRepo) WarmUserFollowerCache(ctx context.Context, userID int64) (int, error) {
 	userFollowerList := make([]*model.UserFollowerModel, 0)
-	result := r.db.WithContext(ctx).Where("user_id=? AND id<=? and status=1", userID, lastID).
+	err := r.db.WithContext(ctx).Where("user_id=?", userID).
 		Order("id desc").
-		Limit(limit).Find(&userFollowerList)
+		Limit(maxWarmCacheSize).Find(&userFollowerList).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, "get user follower for warm cache err")
+	}
 
-	if err := result.Error; err != nil {
-		return nil, errors.Wrapf(err, "get user follower list err")
+	if err := r.cache.MultiSetUserFollowerCache(ctx, userID, userFollowerList, r.ttl); err != nil {
+		return 0, err
 	}
+	for _, v := range userFollowerList {
+		r.localCache.Del(fmt.Sprintf(cache.PrefixUserFollowerCacheKey, userID, v.FollowerUID))
+	}
+	r.localCache.Del(userFollowerListLocalKey(userID))
 
-	return userFollowerList, nil
+	// the set is complete only when all the edges are loaded
+	if len(userFollowerList) < maxWarmCacheSize {
+		followerUIDs := make([]int64, 0, len(userFollowerList))
+		for _, v := range userFollowerList {
+			if v.Status == 1 {
+				followerUIDs = append(followerUIDs, v.FollowerUID)
+			}
+		}
+		if len(followerUIDs) > 0 && len(followerUIDs) <= maxRelationSetSize {
+			err = r.setCache.SetFollowerSetCache(ctx, userID, followerUIDs, r.setTTL)
+		} else {
+			err = r.setCache.DelFollowerSetCache(ctx, userID)
+		}
+	} else {
+		err = r.setCache.DelFollowerSetCache(ctx, userID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(userFollowerList), nil
+}
+
+// PurgeUserFollowerCache delete the caches of the latest followers, the older ones expire by ttl
+func (r *userFollowerRepo) PurgeUserFollowerCache(ctx context.Context, userID int64) (int, error) {
+	followerUIDs := make([]int64, 0)
+	err := r.db.WithContext(ctx).Model(&model.UserFollowerModel{}).Where("user_id=?", userID).
+		Order("id desc").
+		Limit(maxWarmCacheSize).Pluck("follower_uid", &followerUIDs).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, "get user follower for purge cache err")
+	}
+
+	for _, uid := range followerUIDs {
+		if err := r.cache.DelUserFollowerCache(ctx, userID, uid); err != nil {
+			return 0, err
+		}
+		r.localCache.Del(fmt.Sprintf(cache.PrefixUserFollowerCacheKey, userID, uid))
+	}
+	r.localCache.Del(userFollowerListLocalKey(userID))
+	if err := r.setCache.DelFollowerSetCache(ctx, userID); err != nil {
+		return 0, err
+	}
+	return len(followerUIDs), nil
+}
+
+// invalidate delete the caches of the edge
+func (r *userFollowerRepo) invalidate(ctx context.Context, userID, followerUID int64) {
+	invalidate(ctx, func(ctx context.Context) {
+		_ = r.cache.DelUserFollowerCache(ctx, userID, followerUID)
+		_ = r.setCache.DelFollowerSetCache(ctx, userID)
+		r.localCache.Del(fmt.Sprintf(cache.PrefixUserFollowerCacheKey, userID, followerUID))
+		r.localCache.Del(userFollowerListLocalKey(userID))
+	})
+}
+
+// userFollowerListLocalKey the local cache key of the follower list pages of a user
+func userFollowerListLocalKey(userID int64) string {
+	return fmt.Sprintf("user:follower:list:%d", userID)
 }
@@ -5,13 +5,16 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/go-eagle/eagle/pkg/redis"
-
+	"github.com/go-eagle/eagle/pkg/log"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 
 	"github.com/go-microservice/relation-service/internal/cache"
@@ -19,10 +22,31 @@ import (
 )
 
 var (
-	_tableUserFollowingName   = (&model.UserFollowingModel{}).TableName()
-	_insertUserFollowingSQL   = "INSERT INTO %s SET user_id = ?, followed_uid =?, created_at = ?, status = ? on duplicate key update status = ?, updated_at = ?"
+	_tableUserFollowingName = (&model.UserFollowingModel{}).TableName()
+	_insertUserFollowingSQL = "INSERT INTO %s SET user_id = ?, followed_uid =?, created_at = ?, updated_at = ?, status = ?, source = ?, source_meta = ? " +
+		"on duplicate key update status = ?, updated_at = ?, source = ?, source_meta = ?, remark = '', is_special = 0, is_muted = 0"
+	// only the status is written, the attributes and the source of an existing row are kept
+	_upsertUserFollowingStatusSQL = "INSERT INTO %s SET user_id = ?, followed_uid =?, created_at = ?, updated_at = ?, status = ? " +
+		"on duplicate key update status = ?, updated_at = ?"
+	// the attributes are reset only if the edge was unfollowed, they are assigned before status so the old status is used
+	_batchUpsertUserFollowingSQL = "INSERT INTO %s (user_id, followed_uid, status, created_at, updated_at) VALUES %s " +
+		"on duplicate key update remark = IF(status = 1, remark, ''), is_special = IF(status = 1, is_special, 0), " +
+		"is_muted = IF(status = 1, is_muted, 0), status = VALUES(status), updated_at = VALUES(updated_at)"
 	_getUserFollowingSQL      = "SELECT * FROM %s WHERE user_id = %d and followed_uid = %d"
 	_batchGetUserFollowingSQL = "SELECT * FROM %s WHERE id IN (%s)"
+	_getCommonFollowersSQL    = "SELECT a.followed_uid FROM %s a INNER JOIN %s b ON b.follower_uid = a.followed_uid " +
+		"WHERE a.user_id = ? AND a.status = 1 AND b.user_id = ? AND b.status = 1 ORDER BY a.followed_uid DESC LIMIT ?"
+	_countCommonFollowersSQL = "SELECT COUNT(*) FROM %s a INNER JOIN %s b ON b.follower_uid = a.followed_uid " +
+		"WHERE a.user_id = ? AND a.status = 1 AND b.user_id = ? AND b.status = 1"
+)
+
+const (
+	// maxRelationSetSize 超过该数量的关注/粉丝列表不放入缓存集合, eg: 大V的粉丝
+	maxRelationSetSize = 10000
+	// maxWarmCacheSize 预热缓存时最多加载的关系数, 只加载最近的关系
+	maxWarmCacheSize = 10000
+	// maxWarmSetConcurrency 后台同时加载关系集合的最大数量, 超过时跳过本次加载
+	maxWarmSetConcurrency = 16
 )
 
 var _ UserFollowingRepo = (*userFollowingRepo)(nil)
@@ -31,24 +55,59 @@ var _ UserFollowingRepo = (*userFollowingRepo)(nil)
 type UserFollowingRepo interface {
 	CreateUserFollowing(ctx context.Context, db *gorm.DB, data *model.UserFollowingModel) (id int64, err error)
 	UpdateUserFollowingStatus(ctx context.Context, db *gorm.DB, userID, followedUID int64, status int) error
+	// UpsertUserFollowingStatus 写入关注关系的状态, 不存在时创建, 用于管理后台修复数据
+	UpsertUserFollowingStatus(ctx context.Context, db *gorm.DB, data *model.UserFollowingModel) error
+	// 修改备注名、特别关注等属性, attrs 的 key 为字段名
+	UpdateUserFollowingAttributes(ctx context.Context, userID, followedUID int64, attrs map[string]interface{}) error
 	GetUserFollowing(ctx context.Context, userID, followedUID int64) (ret *model.UserFollowingModel, err error)
 	GetUserFollowingWithoutCache(ctx context.Context, userID, followedUID int64) (ret *model.UserFollowingModel, err error)
 	GetFollowingUserList(ctx context.Context, userID, lastID int64, limit int) ([]*model.UserFollowingModel, error)
 	BatchGetUserFollowing(ctx context.Context, userID int64, ids []int64) (ret []*model.UserFollowingModel, err error)
+	// 共同关注: viewer 关注的人中同时关注了 target 的用户, 按用户 id 倒序
+	GetCommonFollowers(ctx context.Context, viewerID, targetID int64, limit int) ([]int64, error)
+	CountCommonFollowers(ctx context.Context, viewerID, targetID int64) (int64, error)
+	// WarmUserFollowingCache 预热用户最近的关注关系缓存和关注集合, 返回加载的关系数
+	WarmUserFollowingCache(ctx context.Context, userID int64) (int, error)
+	// BatchUpsertUserFollowing 批量写入关注关系, 已取消的关系会重新置为关注, 用于导入
+	BatchUpsertUserFollowing(ctx context.Context, db *gorm.DB, data []*model.UserFollowingModel) error
+	// GetActiveUserFollowing 获取 edges 中已关注的关系, 用于导入时跳过已存在的关系
+	GetActiveUserFollowing(ctx context.Context, edges []*model.UserFollowingModel) ([]*model.UserFollowingModel, error)
+	// GetUserFollowingMaxID 获取最大的主键, 用于按主键范围导出
+	GetUserFollowingMaxID(ctx context.Context, db *gorm.DB) (int64, error)
+	// ScanUserFollowing 按主键顺序获取 (lastID, maxID] 范围内的关系, updatedSince 不为零时只获取这之后修改的关系
+	ScanUserFollowing(ctx context.Context, db *gorm.DB, lastID, maxID int64, updatedSince, updatedUntil time.Time, limit int) ([]*model.UserFollowingModel, error)
+	// PurgeUserFollowingCache 删除用户最近的关注关系缓存和关注集合, 返回删除的关系缓存数
+	PurgeUserFollowingCache(ctx context.Context, userID int64) (int, error)
+	// ScanUserFollowingByUser 按主键顺序获取用户 lastID 之后的有效关注关系, 不走缓存, 用于批量处理用户的所有关注
+	ScanUserFollowingByUser(ctx context.Context, db *gorm.DB, userID, lastID int64, limit int) ([]*model.UserFollowingModel, error)
+	// CountUserFollowing 获取用户的关注数, 不走缓存, 用于检查关注数限制
+	CountUserFollowing(ctx context.Context, userID int64) (int64, error)
 }
 
 type userFollowingRepo struct {
-	db     *gorm.DB
-	tracer trace.Tracer
-	cache  cache.UserFollowingCache
+	db         *gorm.DB
+	tracer     trace.Tracer
+	cache      cache.UserFollowingCache
+	setCache   cache.RelationSetCache
+	localCache cache.LocalCache
+	breaker    *cache.Breaker
+	sf         singleflight.Group
+	ttl        cache.TTLConfig
+	warmSem    chan struct{}
 }
 
 // NewUserFollowing new a repository and return
-func NewUserFollowing(db *gorm.DB, cache cache.UserFollowingCache) UserFollowingRepo {
+func NewUserFollowing(db *gorm.DB, userFollowingCache cache.UserFollowingCache, setCache cache.RelationSetCache,
+	breaker *cache.Breaker, cacheCfg *cache.Config) UserFollowingRepo {
 	return &userFollowingRepo{
-		db:     db,
-		tracer: otel.Tracer("userFollowingRepo"),
-		cache:  cache,
+		db:         db,
+		tracer:     otel.Tracer("userFollowingRepo"),
+		cache:      userFollowingCache,
+		setCache:   setCache,
+		localCache: cache.NewLocalCache("user_following", cacheCfg),
+		breaker:    breaker,
+		ttl:        cacheCfg.TTL,
+		warmSem:    make(chan struct{}, maxWarmSetConcurrency),
 	}
 }
 
@@ -57,16 +116,134 @@ func (r *userFollowingRepo) CreateUserFollowing(ctx context.Context, db *gorm.DB
 	_sql := fmt.Sprintf(_insertUserFollowingSQL, _tableUserFollowingName)
 	err = db.WithContext(ctx).Exec(_sql,
 		data.UserID, data.FollowedUID,
-		data.CreatedAt, data.Status,
-		data.Status, data.UpdatedAt,
+		data.CreatedAt, data.UpdatedAt, data.Status, data.Source, data.SourceMeta,
+		data.Status, data.UpdatedAt, data.Source, data.SourceMeta,
 	).Error
 	if err != nil {
 		return 0, errors.Wrap(err, "[repo] create UserFollowing err")
 	}
 
+	// delete cache after commit
+	r.invalidate(ctx, data.UserID, data.FollowedUID)
 	return data.ID, nil
 }
 
+// UpsertUserFollowingStatus create the item or update its status only
+func (r *userFollowingRepo) UpsertUserFollowingStatus(ctx context.Context, db *gorm.DB, data *model.UserFollowingModel) error {
+	_sql := fmt.Sprintf(_upsertUserFollowingStatusSQL, _tableUserFollowingName)
+	err := db.WithContext(ctx).Exec(_sql,
+		data.UserID, data.FollowedUID,
+		data.CreatedAt, data.UpdatedAt, data.Status,
+		data.Status, data.UpdatedAt,
+	).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] upsert UserFollowing status err")
+	}
+
+	// delete cache after commit
+	r.invalidate(ctx, data.UserID, data.FollowedUID)
+	return nil
+}
+
+// BatchUpsertUserFollowing create items in one statement, the caches are deleted after commit
+func (r *userFollowingRepo) BatchUpsertUserFollowing(ctx context.Context, db *gorm.DB, data []*model.UserFollowingModel) error {
+	if len(data) == 0 {
+		return nil
+	}
+	placeholders := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*5)
+	for _, v := range data {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, v.UserID, v.FollowedUID, v.Status, v.CreatedAt, v.UpdatedAt)
+	}
+	_sql := fmt.Sprintf(_batchUpsertUserFollowingSQL, _tableUserFollowingName, strings.Join(placeholders, ","))
+	err := db.WithContext(ctx).Exec(_sql, args...).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] batch upsert UserFollowing err")
+	}
+
+	// delete cache after commit, the set of a user is deleted only once
+	invalidate(ctx, func(ctx context.Context) {
+		userIDs := make(map[int64]struct{})
+		for _, v := range data {
+			_ = r.cache.DelUserFollowingCache(ctx, v.UserID, v.FollowedUID)
+			r.localCache.Del(userFollowingLocalKey(v.UserID, v.FollowedUID))
+			userIDs[v.UserID] = struct{}{}
+		}
+		for userID := range userIDs {
+			_ = r.setCache.DelFollowingSetCache(ctx, userID)
+			r.localCache.Del(userFollowingListLocalKey(userID))
+		}
+	})
+	return nil
+}
+
+// GetActiveUserFollowing get the followed edges by (user_id, followed_uid) pairs
+func (r *userFollowingRepo) GetActiveUserFollowing(ctx context.Context, edges []*model.UserFollowingModel) ([]*model.UserFollowingModel, error) {
+	ret := make([]*model.UserFollowingModel, 0)
+	if len(edges) == 0 {
+		return ret, nil
+	}
+	pairs := make([][]interface{}, 0, len(edges))
+	for _, v := range edges {
+		pairs = append(pairs, []interface{}{v.UserID, v.FollowedUID})
+	}
+	err := r.db.WithContext(ctx).Where("(user_id, followed_uid) IN ? AND status=1", pairs).Find(&ret).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get active UserFollowing err")
+	}
+	return ret, nil
+}
+
+// GetUserFollowingMaxID get the max primary key
+func (r *userFollowingRepo) GetUserFollowingMaxID(ctx context.Context, db *gorm.DB) (int64, error) {
+	var maxID int64
+	err := db.WithContext(ctx).Model(&model.UserFollowingModel{}).Select("COALESCE(MAX(id), 0)").Scan(&maxID).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] get UserFollowing max id err")
+	}
+	return maxID, nil
+}
+
+// ScanUserFollowing get items by primary key range, the edges of any status are returned
+func (r *userFollowingRepo) ScanUserFollowing(ctx context.Context, db *gorm.DB, lastID, maxID int64,
+	updatedSince, updatedUntil time.Time, limit int) ([]*model.UserFollowingModel, error) {
+	query := db.WithContext(ctx).Where("id>? AND id<=?", lastID, maxID)
+	if !updatedSince.IsZero() {
+		// updated_at of the rows inserted before it was set on insert is NULL
+		query = query.Where("(updated_at>=? AND updated_at<?) OR (updated_at IS NULL AND created_at>=? AND created_at<?)",
+			updatedSince, updatedUntil, updatedSince, updatedUntil)
+	}
+	ret := make([]*model.UserFollowingModel, 0, limit)
+	err := query.Order("id asc").Limit(limit).Find(&ret).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] scan UserFollowing err")
+	}
+	return ret, nil
+}
+
+// ScanUserFollowingByUser scan the active followings of a user by id, the rows updated during the scan are not skipped
+func (r *userFollowingRepo) ScanUserFollowingByUser(ctx context.Context, db *gorm.DB, userID, lastID int64, limit int) ([]*model.UserFollowingModel, error) {
+	ret := make([]*model.UserFollowingModel, 0, limit)
+	err := db.WithContext(ctx).Where("user_id=? AND id>? AND status=1", userID, lastID).
+		Order("id asc").Limit(limit).Find(&ret).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] scan UserFollowing by user err")
+	}
+	return ret, nil
+}
+
+// CountUserFollowing count the active followings of the user
+func (r *userFollowingRepo) CountUserFollowing(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.UserFollowingModel{}).
+		Where("user_id=? AND status=1", userID).Count(&count).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] count UserFollowing err")
+	}
+	return count, nil
+}
+
 // UpdateUserFollowing update item
 func (r *userFollowingRepo) UpdateUserFollowingStatus(ctx context.Context, db *gorm.DB, userID, followedUID int64, status int) error {
 	userFollow := model.UserFollowingModel{}
@@ -76,33 +253,68 @@ func (r *userFollowingRepo) UpdateUserFollowingStatus(ctx context.Context, db *g
 		return err
 	}
 
-	// delete cache
-	_ = r.cache.DelUserFollowingCache(ctx, userID, followedUID)
+	// delete cache after commit
+	r.invalidate(ctx, userID, followedUID)
+	return nil
+}
+
+// UpdateUserFollowingAttributes update edge attributes
+func (r *userFollowingRepo) UpdateUserFollowingAttributes(ctx context.Context, userID, followedUID int64, attrs map[string]interface{}) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+	attrs["updated_at"] = time.Now()
+	err := r.db.WithContext(ctx).Model(&model.UserFollowingModel{}).
+		Where("user_id=? and followed_uid=? and status=1", userID, followedUID).
+		Updates(attrs).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] update UserFollowing attributes err")
+	}
+
+	// delete cache by the invalidation batch of ctx, so that it is deleted again after a delay
+	r.invalidate(ctx, userID, followedUID)
 	return nil
 }
 
 // GetUserFollowing get a record
 func (r *userFollowingRepo) GetUserFollowing(ctx context.Context, userID, followedUID int64) (ret *model.UserFollowingModel, err error) {
-	// read cache
-	item, err := r.cache.GetUserFollowingCache(ctx, userID, followedUID)
-	if err != nil && !errors.Is(err, redis.ErrRedisNotFound) {
-		return nil, err
+	localKey := userFollowingLocalKey(userID, followedUID)
+	if val, ok := r.localCache.Get(localKey); ok {
+		return val.(*model.UserFollowingModel), nil
 	}
+
+	// read cache, fall back to DB if redis is unavailable
+	item, cacheErr := r.cache.GetUserFollowingCache(ctx, userID, followedUID)
 	if item != nil {
+		r.localCache.Set(localKey, item)
 		return item, nil
 	}
-	data := new(model.UserFollowingModel)
-	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_getUserFollowingSQL, _tableUserFollowingName, userID, followedUID)).Scan(&data).Error
-	if err != nil {
-		return
-	}
 
-	if data != nil && data.ID > 0 {
-		err = r.cache.SetUserFollowingCache(ctx, userID, followedUID, data, 5*time.Minute)
+	// only one request of the same key loads from DB, the others wait for its result
+	val, err, _ := r.sf.Do(localKey, func() (interface{}, error) {
+		// not canceled by the first caller, the result is shared
+		ctx := context.WithoutCancel(ctx)
+		data := new(model.UserFollowingModel)
+		err := loadFromDB(ctx, r.breaker, cacheErr, func() error {
+			return r.db.WithContext(ctx).Raw(fmt.Sprintf(_getUserFollowingSQL, _tableUserFollowingName, userID, followedUID)).Scan(&data).Error
+		})
 		if err != nil {
 			return nil, err
 		}
+		if data != nil && data.ID > 0 && !cacheUnavailable(cacheErr) {
+			// the result is still returned if it fails to write cache
+			err = r.cache.SetUserFollowingCache(ctx, userID, followedUID, data, r.ttl.UserFollowing)
+			if err != nil {
+				log.WithContext(ctx).Warnf("set user following cache err: %+v", err)
+			}
+		}
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	data := val.(*model.UserFollowingModel)
+	r.localCache.Set(localKey, data)
 	return data, nil
 }
 
@@ -115,14 +327,56 @@ func (r *userFollowingRepo) GetUserFollowingWithoutCache(ctx context.Context, us
 	return data, nil
 }
 
-// BatchGetUserFollowing get a record
+// BatchGetUserFollowing get the followed records, read the per-pair cache first
 func (r *userFollowingRepo) BatchGetUserFollowing(ctx context.Context, userID int64, ids []int64) (ret []*model.UserFollowingModel, err error) {
-	userFollowList := make([]*model.UserFollowingModel, 0)
-	result := r.db.WithContext(ctx).Where("user_id=? AND followed_uid in (?) and status=1", userID, ids).
-		Find(&userFollowList)
+	cached, cacheErr := r.cache.MultiGetUserFollowingCache(ctx, userID, ids)
+	if cacheUnavailable(cacheErr) {
+		log.WithContext(ctx).Warnf("multi get user following from cache err: %+v", cacheErr)
+		cached = make(map[int64]*model.UserFollowingModel)
+	}
+
+	userFollowList := make([]*model.UserFollowingModel, 0, len(ids))
+	missIDs := make([]int64, 0)
+	for _, id := range ids {
+		item, ok := cached[id]
+		if !ok {
+			missIDs = append(missIDs, id)
+			continue
+		}
+		if item.Status == 1 {
+			userFollowList = append(userFollowList, item)
+		}
+	}
+	if len(missIDs) == 0 {
+		return userFollowList, nil
+	}
 
-	if err := result.Error; err != nil {
-		return nil, errors.Wrapf(err, "batch get user follow err")
+	// the same batch is requested together when a feed page is rendered by many viewers
+	val, err, _ := r.sf.Do(batchFollowingFlightKey(userID, missIDs), func() (interface{}, error) {
+		ctx := context.WithoutCancel(ctx)
+		missList := make([]*model.UserFollowingModel, 0)
+		err := loadFromDB(ctx, r.breaker, cacheErr, func() error {
+			return r.db.WithContext(ctx).Where("user_id=? AND followed_uid in (?)", userID, missIDs).
+				Find(&missList).Error
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "batch get user follow err")
+		}
+		for _, v := range missList {
+			if !cacheUnavailable(cacheErr) {
+				_ = r.cache.SetUserFollowingCache(ctx, userID, v.FollowedUID, v, r.ttl.UserFollowing)
+			}
+		}
+		return missList, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range val.([]*model.UserFollowingModel) {
+		if v.Status == 1 {
+			userFollowList = append(userFollowList, v)
+		}
 	}
 
 	return userFollowList, nil
@@ -130,14 +384,251 @@ func (r *userFollowingRepo) BatchGetUserFollowing(ctx context.Context, userID in
 
 // GetFollowingUserList 获取关注的用户列表
 func (r *userFollowingRepo) GetFollowingUserList(ctx context.Context, userID, lastID int64, limit int) ([]*model.UserFollowingModel, error) {
+	localKey := userFollowingListLocalKey(userID)
+	page := fmt.Sprintf("%d_%d", lastID, limit)
+	val, pages, ok := cache.GetLocalPage(r.localCache, localKey, page)
+	if ok {
+		return val.([]*model.UserFollowingModel), nil
+	}
+
+	val, err, _ := r.sf.Do(localKey+":"+page, func() (interface{}, error) {
+		ctx := context.WithoutCancel(ctx)
+		userFollowList := make([]*model.UserFollowingModel, 0)
+		result := r.db.WithContext(ctx).Where("user_id=? AND id<=? and status=1", userID, lastID).
+			Order("id desc").
+			Limit(limit).Find(&userFollowList)
+
+		if err := result.Error; err != nil {
+			return nil, errors.Wrapf(err, "get user follow list err")
+		}
+		return userFollowList, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userFollowList := val.([]*model.UserFollowingModel)
+	cache.SetLocalPage(r.localCache, localKey, pages, page, userFollowList)
+	return userFollowList, nil
+}
+
+// GetCommonFollowers 获取共同关注, 优先使用缓存集合求交集, 否则回源到 DB
+// NOTE: the sets have no follow ids, so both paths order by the user id to return the same users
+func (r *userFollowingRepo) GetCommonFollowers(ctx context.Context, viewerID, targetID int64, limit int) ([]int64, error) {
+	uids, ok, err := r.setCache.GetCommonFollowersCache(ctx, viewerID, targetID)
+	if err != nil {
+		log.WithContext(ctx).Warnf("get common followers from cache err: %+v", err)
+	}
+	if ok {
+		sort.Slice(uids, func(i, j int) bool { return uids[i] > uids[j] })
+		if len(uids) > limit {
+			uids = uids[:limit]
+		}
+		return uids, nil
+	}
+
+	uids = make([]int64, 0)
+	_sql := fmt.Sprintf(_getCommonFollowersSQL, _tableUserFollowingName, _tableUserFollowerName)
+	dbErr := loadFromDB(ctx, r.breaker, err, func() error {
+		return r.db.WithContext(ctx).Raw(_sql, viewerID, targetID, limit).Scan(&uids).Error
+	})
+	if dbErr != nil {
+		return nil, errors.Wrapf(dbErr, "get common followers err")
+	}
+
+	// no need to warm the sets when redis is unavailable
+	if err == nil {
+		r.warmCommonFollowersCache(ctx, viewerID, targetID)
+	}
+	return uids, nil
+}
+
+// CountCommonFollowers 获取共同关注数
+func (r *userFollowingRepo) CountCommonFollowers(ctx context.Context, viewerID, targetID int64) (int64, error) {
+	count, ok, err := r.setCache.CountCommonFollowersCache(ctx, viewerID, targetID)
+	if err != nil {
+		log.WithContext(ctx).Warnf("count common followers from cache err: %+v", err)
+	}
+	if ok {
+		return count, nil
+	}
+
+	_sql := fmt.Sprintf(_countCommonFollowersSQL, _tableUserFollowingName, _tableUserFollowerName)
+	dbErr := loadFromDB(ctx, r.breaker, err, func() error {
+		return r.db.WithContext(ctx).Raw(_sql, viewerID, targetID).Scan(&count).Error
+	})
+	if dbErr != nil {
+		return 0, errors.Wrapf(dbErr, "count common followers err")
+	}
+
+	if err == nil {
+		r.warmCommonFollowersCache(ctx, viewerID, targetID)
+	}
+	return count, nil
+}
+
+// WarmUserFollowingCache load the latest followings into cache, the unfollowed edges are loaded too
+// to overwrite the stale values.
+func (r *userFollowingRepo) WarmUserFollowingCache(ctx context.Context, userID int64) (int, error) {
 	userFollowList := make([]*model.UserFollowingModel, 0)
-	result := r.db.WithContext(ctx).Where("user_id=? AND id<=? and status=1", userID, lastID).
+	err := r.db.WithContext(ctx).Where("user_id=?", userID).
 		Order("id desc").
-		Limit(limit).Find(&userFollowList)
+		Limit(maxWarmCacheSize).Find(&userFollowList).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, "get user following for warm cache err")
+	}
 
-	if err := result.Error; err != nil {
-		return nil, errors.Wrapf(err, "get user follow list err")
+	if err := r.cache.MultiSetUserFollowingCache(ctx, userID, userFollowList, r.ttl.UserFollowing); err != nil {
+		return 0, err
 	}
+	for _, v := range userFollowList {
+		r.localCache.Del(userFollowingLocalKey(userID, v.FollowedUID))
+	}
+	r.localCache.Del(userFollowingListLocalKey(userID))
 
-	return userFollowList, nil
+	// the set is complete only when all the edges are loaded
+	if len(userFollowList) < maxWarmCacheSize {
+		followedUIDs := make([]int64, 0, len(userFollowList))
+		for _, v := range userFollowList {
+			if v.Status == 1 {
+				followedUIDs = append(followedUIDs, v.FollowedUID)
+			}
+		}
+		if len(followedUIDs) > 0 && len(followedUIDs) <= maxRelationSetSize {
+			err = r.setCache.SetFollowingSetCache(ctx, userID, followedUIDs, r.ttl.RelationSet)
+		} else {
+			err = r.setCache.DelFollowingSetCache(ctx, userID)
+		}
+	} else {
+		err = r.setCache.DelFollowingSetCache(ctx, userID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(userFollowList), nil
+}
+
+// PurgeUserFollowingCache delete the caches of the latest followings, the older ones expire by ttl
+func (r *userFollowingRepo) PurgeUserFollowingCache(ctx context.Context, userID int64) (int, error) {
+	followedUIDs := make([]int64, 0)
+	err := r.db.WithContext(ctx).Model(&model.UserFollowingModel{}).Where("user_id=?", userID).
+		Order("id desc").
+		Limit(maxWarmCacheSize).Pluck("followed_uid", &followedUIDs).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, "get user following for purge cache err")
+	}
+
+	for _, uid := range followedUIDs {
+		if err := r.cache.DelUserFollowingCache(ctx, userID, uid); err != nil {
+			return 0, err
+		}
+		r.localCache.Del(userFollowingLocalKey(userID, uid))
+	}
+	r.localCache.Del(userFollowingListLocalKey(userID))
+	if err := r.setCache.DelFollowingSetCache(ctx, userID); err != nil {
+		return 0, err
+	}
+	return len(followedUIDs), nil
+}
+
+// warmCommonFollowersCache load viewer's following set and target's follower set into cache in background,
+// the read path is not blocked. The loads are skipped if too many are in progress.
+func (r *userFollowingRepo) warmCommonFollowersCache(ctx context.Context, viewerID, targetID int64) {
+	select {
+	case r.warmSem <- struct{}{}:
+	default:
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-r.warmSem }()
+
+		// the lists which are too large are marked, they are served by DB until the marker expires
+		followingOversize, followerOversize, err := r.setCache.GetSetOversize(ctx, viewerID, targetID)
+		if err != nil {
+			return
+		}
+		if !followingOversize {
+			_, _, _ = r.sf.Do(fmt.Sprintf("warm:following:set:%d", viewerID), func() (interface{}, error) {
+				r.loadFollowingSet(ctx, viewerID)
+				return nil, nil
+			})
+		}
+		if !followerOversize {
+			_, _, _ = r.sf.Do(fmt.Sprintf("warm:follower:set:%d", targetID), func() (interface{}, error) {
+				r.loadFollowerSet(ctx, targetID)
+				return nil, nil
+			})
+		}
+	}()
+}
+
+// loadFollowingSet load the following set, or mark it oversize
+func (r *userFollowingRepo) loadFollowingSet(ctx context.Context, userID int64) {
+	followingUIDs := make([]int64, 0)
+	err := r.db.WithContext(ctx).Model(&model.UserFollowingModel{}).
+		Where("user_id=? AND status=1", userID).
+		Limit(maxRelationSetSize+1).Pluck("followed_uid", &followingUIDs).Error
+	if err != nil {
+		log.WithContext(ctx).Warnf("load following set err: %+v, user_id: %d", err, userID)
+		return
+	}
+	if len(followingUIDs) > maxRelationSetSize {
+		_ = r.setCache.SetFollowingSetOversize(ctx, userID, r.ttl.RelationSet)
+		return
+	}
+	_ = r.setCache.SetFollowingSetCache(ctx, userID, followingUIDs, r.ttl.RelationSet)
+}
+
+// loadFollowerSet load the follower set, or mark it oversize
+func (r *userFollowingRepo) loadFollowerSet(ctx context.Context, userID int64) {
+	followerUIDs := make([]int64, 0)
+	err := r.db.WithContext(ctx).Model(&model.UserFollowerModel{}).
+		Where("user_id=? AND status=1", userID).
+		Limit(maxRelationSetSize+1).Pluck("follower_uid", &followerUIDs).Error
+	if err != nil {
+		log.WithContext(ctx).Warnf("load follower set err: %+v, user_id: %d", err, userID)
+		return
+	}
+	if len(followerUIDs) > maxRelationSetSize {
+		_ = r.setCache.SetFollowerSetOversize(ctx, userID, r.ttl.RelationSet)
+		return
+	}
+	_ = r.setCache.SetFollowerSetCache(ctx, userID, followerUIDs, r.ttl.RelationSet)
+}
+
+// invalidate delete the caches of the edge
+func (r *userFollowingRepo) invalidate(ctx context.Context, userID, followedUID int64) {
+	invalidate(ctx, func(ctx context.Context) {
+		_ = r.cache.DelUserFollowingCache(ctx, userID, followedUID)
+		_ = r.setCache.DelFollowingSetCache(ctx, userID)
+		r.localCache.Del(userFollowingLocalKey(userID, followedUID))
+		r.localCache.Del(userFollowingListLocalKey(userID))
+	})
+}
+
+// userFollowingLocalKey the local cache key of a following edge
+func userFollowingLocalKey(userID, followedUID int64) string {
+	return fmt.Sprintf(cache.PrefixUserFollowingCacheKey, userID, followedUID)
+}
+
+// batchFollowingFlightKey the singleflight key of a batch, the ids are sorted so that the order does not matter
+func batchFollowingFlightKey(userID int64, ids []int64) string {
+	sorted := make([]int64, len(ids))
+	copy(sorted, ids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var b strings.Builder
+	b.WriteString("batch:following:")
+	b.WriteString(strconv.FormatInt(userID, 10))
+	for _, id := range sorted {
+		b.WriteByte(',')
+		b.WriteString(strconv.FormatInt(id, 10))
+	}
+	return b.String()
+}
+
+// userFollowingListLocalKey the local cache key of the following list pages of a user
+func userFollowingListLocalKey(userID int64) string {
+	return fmt.Sprintf("user:following:list:%d", userID)
 }
@@ -0,0 +1,122 @@
+package repository
+
+//go:generate mockgen -source=user_relation_merge_repo.go -destination=../../internal/mocks/user_relation_merge_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var _ UserRelationMergeRepo = (*userRelationMergeRepo)(nil)
+
+// UserRelationMergeRepo define a repo interface
+type UserRelationMergeRepo interface {
+	CreateUserRelationMerge(ctx context.Context, data *model.UserRelationMergeModel) (id int64, err error)
+	GetUserRelationMerge(ctx context.Context, id int64) (ret *model.UserRelationMergeModel, err error)
+	// GetUnfinishedUserRelationMerge 获取两个用户之间等待执行或执行中的合并
+	GetUnfinishedUserRelationMerge(ctx context.Context, fromUID, toUID int64) (ret *model.UserRelationMergeModel, err error)
+	// UpdateUserRelationMergeProgress 保存游标和统计, 状态改为执行中
+	UpdateUserRelationMergeProgress(ctx context.Context, data *model.UserRelationMergeModel) error
+	// FinishUserRelationMerge 标记为完成或失败
+	FinishUserRelationMerge(ctx context.Context, id int64, status int, errMsg string) error
+}
+
+type userRelationMergeRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewUserRelationMerge new a repository and return
+func NewUserRelationMerge(db *gorm.DB) UserRelationMergeRepo {
+	return &userRelationMergeRepo{
+		db:     db,
+		tracer: otel.Tracer("userRelationMergeRepo"),
+	}
+}
+
+// CreateUserRelationMerge create a item
+func (r *userRelationMergeRepo) CreateUserRelationMerge(ctx context.Context, data *model.UserRelationMergeModel) (id int64, err error) {
+	err = r.db.WithContext(ctx).Create(data).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] create UserRelationMerge err")
+	}
+
+	return data.ID, nil
+}
+
+// GetUserRelationMerge get a record, nil is returned if it does not exist
+func (r *userRelationMergeRepo) GetUserRelationMerge(ctx context.Context, id int64) (ret *model.UserRelationMergeModel, err error) {
+	data := make([]*model.UserRelationMergeModel, 0)
+	err = r.db.WithContext(ctx).Where("id=?", id).Limit(1).Find(&data).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get UserRelationMerge err")
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data[0], nil
+}
+
+// GetUnfinishedUserRelationMerge get the latest pending or running merge
+func (r *userRelationMergeRepo) GetUnfinishedUserRelationMerge(ctx context.Context, fromUID, toUID int64) (ret *model.UserRelationMergeModel, err error) {
+	data := make([]*model.UserRelationMergeModel, 0)
+	err = r.db.WithContext(ctx).Where("from_uid=? AND to_uid=? AND status IN (?)", fromUID, toUID,
+		[]int{model.UserRelationMergeStatusPending, model.UserRelationMergeStatusRunning}).
+		Order("id desc").Limit(1).Find(&data).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get unfinished UserRelationMerge err")
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data[0], nil
+}
+
+// UpdateUserRelationMergeProgress update the cursor and the stats
+func (r *userRelationMergeRepo) UpdateUserRelationMergeProgress(ctx context.Context, data *model.UserRelationMergeModel) error {
+	err := r.db.WithContext(ctx).Model(&model.UserRelationMergeModel{}).
+		Where("id=?", data.ID).
+		Updates(map[string]interface{}{
+			"status":      model.UserRelationMergeStatusRunning,
+			"phase":       data.Phase,
+			"last_id":     data.LastID,
+			"removed":     data.Removed,
+			"transferred": data.Transferred,
+			"duplicates":  data.Duplicates,
+			"over_limit":  data.OverLimit,
+			"unavailable": data.Unavailable,
+			"updated_at":  time.Now(),
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] update UserRelationMerge progress err")
+	}
+	return nil
+}
+
+// FinishUserRelationMerge update the status and the finish time
+func (r *userRelationMergeRepo) FinishUserRelationMerge(ctx context.Context, id int64, status int, errMsg string) error {
+	// the length of the error column
+	if len(errMsg) > 1024 {
+		errMsg = errMsg[:1024]
+	}
+	curTime := time.Now()
+	err := r.db.WithContext(ctx).Model(&model.UserRelationMergeModel{}).
+		Where("id=?", id).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"error":       errMsg,
+			"updated_at":  curTime,
+			"finished_at": curTime,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] finish UserRelationMerge err")
+	}
+	return nil
+}
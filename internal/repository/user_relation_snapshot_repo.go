@@ -0,0 +1,289 @@
+package repository
+
+//go:generate mockgen -source=user_relation_snapshot_repo.go -destination=../../internal/mocks/user_relation_snapshot_repo_mock.go  -package mocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+var (
+	_tableUserRelationSnapshotName = (&model.UserRelationSnapshotModel{}).TableName()
+	// 当天关注或被关注关系有变化的用户
+	_getSnapshotActiveUserSQL = "SELECT user_id FROM (" +
+		"SELECT user_id FROM %[1]s WHERE created_at >= ? AND created_at < ? " +
+		"UNION SELECT target_uid AS user_id FROM %[1]s WHERE created_at >= ? AND created_at < ?" +
+		") t WHERE user_id > ? ORDER BY user_id LIMIT ?"
+	_getFollowerChangeSQL = "SELECT target_uid AS user_id, " +
+		"SUM(CASE WHEN action = ? THEN 1 ELSE 0 END) AS follower_gain, " +
+		"SUM(CASE WHEN action = ? THEN 1 ELSE 0 END) AS follower_loss " +
+		"FROM %s WHERE created_at >= ? AND created_at < ? AND target_uid IN (?) GROUP BY target_uid"
+	_countRelationByUserSQL = "SELECT user_id, COUNT(*) AS cnt FROM %s WHERE user_id IN (?) AND status = 1 GROUP BY user_id"
+	// 某个时间之后的净变化, 用当前的关系数减去它得到当时的关系数
+	_getFollowerChangeAfterSQL = "SELECT target_uid AS user_id, " +
+		"SUM(CASE WHEN action = ? THEN 1 WHEN action = ? THEN -1 ELSE 0 END) AS cnt " +
+		"FROM %s WHERE created_at >= ? AND target_uid IN (?) GROUP BY target_uid"
+	_getFollowingChangeAfterSQL = "SELECT user_id, " +
+		"SUM(CASE WHEN action = ? THEN 1 WHEN action = ? THEN -1 ELSE 0 END) AS cnt " +
+		"FROM %s WHERE created_at >= ? AND user_id IN (?) GROUP BY user_id"
+	_getFollowingChangeSQL = "SELECT COALESCE(SUM(CASE WHEN action = ? THEN 1 WHEN action = ? THEN -1 ELSE 0 END), 0) " +
+		"FROM %s WHERE user_id = ? AND created_at >= ? AND created_at < ?"
+	_batchUpsertUserRelationSnapshotSQL = "INSERT INTO %s (user_id, stat_date, follower_count, following_count, follower_gain, follower_loss, created_at) " +
+		"VALUES %s on duplicate key update follower_count = VALUES(follower_count), following_count = VALUES(following_count), " +
+		"follower_gain = VALUES(follower_gain), follower_loss = VALUES(follower_loss)"
+	// the counts are unsigned, they are cast to signed before adding a negative delta
+	_incrUserRelationSnapshotSQL = "UPDATE %s SET following_count = GREATEST(CAST(following_count AS SIGNED) + ?, 0), " +
+		"follower_count = GREATEST(CAST(follower_count AS SIGNED) + ?, 0), follower_gain = follower_gain + ?, " +
+		"follower_loss = follower_loss + ? WHERE user_id = ? AND stat_date = ?"
+	_deleteUserRelationSnapshotSQL = "DELETE FROM %s WHERE stat_date < ? LIMIT ?"
+	_getTopFollowedUserSQL         = "SELECT user_id FROM %s WHERE stat_date >= ? " +
+		"GROUP BY user_id ORDER BY MAX(follower_count) DESC LIMIT ?"
+)
+
+var _ UserRelationSnapshotRepo = (*userRelationSnapshotRepo)(nil)
+
+// UserRelationSnapshotRepo define a repo interface
+type UserRelationSnapshotRepo interface {
+	// GetSnapshotActiveUserIDs 获取某天关系有变化的用户
+	GetSnapshotActiveUserIDs(ctx context.Context, day time.Time, lastUserID int64, limit int) ([]int64, error)
+	// BuildUserRelationSnapshot 根据关系变更日志和当前关系数生成快照, 当天结束时的关系数为当前关系数减去之后的净变化
+	BuildUserRelationSnapshot(ctx context.Context, day time.Time, userIDs []int64) ([]*model.UserRelationSnapshotModel, error)
+	BatchUpsertUserRelationSnapshot(ctx context.Context, data []*model.UserRelationSnapshotModel) error
+	// IncrUserRelationSnapshot 在当天的快照上累加一次关系变化, 快照不存在或没有变化时返回 false
+	IncrUserRelationSnapshot(ctx context.Context, day time.Time, userID int64, delta *model.UserRelationSnapshotModel) (bool, error)
+	// GetUserFollowingChange 用户某天关注数的净变化, 由关系变更日志统计, 超过日志保留天数时为 0
+	GetUserFollowingChange(ctx context.Context, userID int64, day time.Time) (int64, error)
+	// GetUserRelationSnapshotList 获取日期范围内的快照, 包含 startDate 之前最近的一条
+	GetUserRelationSnapshotList(ctx context.Context, userID int64, startDate, endDate time.Time) ([]*model.UserRelationSnapshotModel, error)
+	DeleteUserRelationSnapshotBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+	// RefreshUserRelationSnapshot 重算用户当天的关注数和粉丝数并写入快照
+	RefreshUserRelationSnapshot(ctx context.Context, day time.Time, userIDs []int64) ([]*model.UserRelationSnapshotModel, error)
+	// GetTopFollowedUserIDs 粉丝数最多的用户, 只统计 since 之后有快照的用户
+	GetTopFollowedUserIDs(ctx context.Context, since time.Time, limit int) ([]int64, error)
+}
+
+type userRelationSnapshotRepo struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+// NewUserRelationSnapshot new a repository and return
+func NewUserRelationSnapshot(db *gorm.DB) UserRelationSnapshotRepo {
+	return &userRelationSnapshotRepo{
+		db:     db,
+		tracer: otel.Tracer("userRelationSnapshotRepo"),
+	}
+}
+
+// GetSnapshotActiveUserIDs get the users whose relations changed in the day
+func (r *userRelationSnapshotRepo) GetSnapshotActiveUserIDs(ctx context.Context, day time.Time, lastUserID int64, limit int) ([]int64, error) {
+	start, end := dayRange(day)
+	userIDs := make([]int64, 0)
+	_sql := fmt.Sprintf(_getSnapshotActiveUserSQL, _tableRelationLogName)
+	err := r.db.WithContext(ctx).Raw(_sql, start, end, start, end, lastUserID, limit).Scan(&userIDs).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get snapshot active users err")
+	}
+	return userIDs, nil
+}
+
+// BuildUserRelationSnapshot build snapshots of the users
+func (r *userRelationSnapshotRepo) BuildUserRelationSnapshot(ctx context.Context, day time.Time, userIDs []int64) ([]*model.UserRelationSnapshotModel, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	start, end := dayRange(day)
+
+	snapshots := make(map[int64]*model.UserRelationSnapshotModel, len(userIDs))
+	for _, userID := range userIDs {
+		snapshots[userID] = &model.UserRelationSnapshotModel{UserID: userID, StatDate: start}
+	}
+
+	changes := make([]*model.UserRelationSnapshotModel, 0)
+	_sql := fmt.Sprintf(_getFollowerChangeSQL, _tableRelationLogName)
+	err := r.db.WithContext(ctx).Raw(_sql, model.RelationLogActionFollow, model.RelationLogActionUnfollow,
+		start, end, userIDs).Scan(&changes).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get follower changes err")
+	}
+	for _, v := range changes {
+		if item, ok := snapshots[v.UserID]; ok {
+			item.FollowerGain = v.FollowerGain
+			item.FollowerLoss = v.FollowerLoss
+		}
+	}
+
+	type relationCount struct {
+		UserID int64 `gorm:"column:user_id"`
+		Cnt    int64 `gorm:"column:cnt"`
+	}
+	followerCounts := make([]*relationCount, 0)
+	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_countRelationByUserSQL, _tableUserFollowerName), userIDs).
+		Scan(&followerCounts).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] count followers err")
+	}
+	for _, v := range followerCounts {
+		snapshots[v.UserID].FollowerCount = v.Cnt
+	}
+	followingCounts := make([]*relationCount, 0)
+	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_countRelationByUserSQL, _tableUserFollowingName), userIDs).
+		Scan(&followingCounts).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] count followings err")
+	}
+	for _, v := range followingCounts {
+		snapshots[v.UserID].FollowingCount = v.Cnt
+	}
+
+	// rewind the counts to the end of the day, so that a past day is not recorded with the current counts
+	followerChanges := make([]*relationCount, 0)
+	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_getFollowerChangeAfterSQL, _tableRelationLogName),
+		model.RelationLogActionFollow, model.RelationLogActionUnfollow, end, userIDs).Scan(&followerChanges).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get follower changes after the day err")
+	}
+	for _, v := range followerChanges {
+		snapshots[v.UserID].FollowerCount -= v.Cnt
+	}
+	followingChanges := make([]*relationCount, 0)
+	err = r.db.WithContext(ctx).Raw(fmt.Sprintf(_getFollowingChangeAfterSQL, _tableRelationLogName),
+		model.RelationLogActionFollow, model.RelationLogActionUnfollow, end, userIDs).Scan(&followingChanges).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get following changes after the day err")
+	}
+	for _, v := range followingChanges {
+		snapshots[v.UserID].FollowingCount -= v.Cnt
+	}
+	for _, v := range snapshots {
+		// the edges written without logs, eg: the import, may make it negative
+		if v.FollowerCount < 0 {
+			v.FollowerCount = 0
+		}
+		if v.FollowingCount < 0 {
+			v.FollowingCount = 0
+		}
+	}
+
+	ret := make([]*model.UserRelationSnapshotModel, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ret = append(ret, snapshots[userID])
+	}
+	return ret, nil
+}
+
+// BatchUpsertUserRelationSnapshot create or overwrite items
+func (r *userRelationSnapshotRepo) BatchUpsertUserRelationSnapshot(ctx context.Context, data []*model.UserRelationSnapshotModel) error {
+	if len(data) == 0 {
+		return nil
+	}
+	curTime := time.Now()
+	placeholders := make([]string, 0, len(data))
+	args := make([]interface{}, 0, len(data)*7)
+	for _, v := range data {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, v.UserID, v.StatDate.Format("2006-01-02"), v.FollowerCount, v.FollowingCount,
+			v.FollowerGain, v.FollowerLoss, curTime)
+	}
+	_sql := fmt.Sprintf(_batchUpsertUserRelationSnapshotSQL, _tableUserRelationSnapshotName, strings.Join(placeholders, ","))
+	err := r.db.WithContext(ctx).Exec(_sql, args...).Error
+	if err != nil {
+		return errors.Wrap(err, "[repo] batch upsert UserRelationSnapshot err")
+	}
+	return nil
+}
+
+// RefreshUserRelationSnapshot build and overwrite the snapshots of the day
+func (r *userRelationSnapshotRepo) RefreshUserRelationSnapshot(ctx context.Context, day time.Time, userIDs []int64) ([]*model.UserRelationSnapshotModel, error) {
+	snapshots, err := r.BuildUserRelationSnapshot(ctx, day, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.BatchUpsertUserRelationSnapshot(ctx, snapshots); err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// IncrUserRelationSnapshot add the counts of delta to the snapshot of the day
+func (r *userRelationSnapshotRepo) IncrUserRelationSnapshot(ctx context.Context, day time.Time, userID int64,
+	delta *model.UserRelationSnapshotModel) (bool, error) {
+	start, _ := dayRange(day)
+	_sql := fmt.Sprintf(_incrUserRelationSnapshotSQL, _tableUserRelationSnapshotName)
+	result := r.db.WithContext(ctx).Exec(_sql, delta.FollowingCount, delta.FollowerCount,
+		delta.FollowerGain, delta.FollowerLoss, userID, start.Format("2006-01-02"))
+	if err := result.Error; err != nil {
+		return false, errors.Wrap(err, "[repo] incr UserRelationSnapshot err")
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetUserFollowingChange the follows minus the unfollows of the user in the day
+func (r *userRelationSnapshotRepo) GetUserFollowingChange(ctx context.Context, userID int64, day time.Time) (int64, error) {
+	start, end := dayRange(day)
+	var change int64
+	err := r.db.WithContext(ctx).Raw(fmt.Sprintf(_getFollowingChangeSQL, _tableRelationLogName),
+		model.RelationLogActionFollow, model.RelationLogActionUnfollow, userID, start, end).Scan(&change).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "[repo] get following change err")
+	}
+	return change, nil
+}
+
+// GetUserRelationSnapshotList get snapshots between the dates, both inclusive
+func (r *userRelationSnapshotRepo) GetUserRelationSnapshotList(ctx context.Context, userID int64, startDate, endDate time.Time) ([]*model.UserRelationSnapshotModel, error) {
+	// the latest one before start date is the base value of the first point
+	ret := make([]*model.UserRelationSnapshotModel, 0)
+	err := r.db.WithContext(ctx).Where("user_id=? AND stat_date<?", userID, startDate.Format("2006-01-02")).
+		Order("stat_date desc").Limit(1).Find(&ret).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get UserRelationSnapshot base err")
+	}
+
+	snapshotList := make([]*model.UserRelationSnapshotModel, 0)
+	err = r.db.WithContext(ctx).Where("user_id=? AND stat_date>=? AND stat_date<=?", userID,
+		startDate.Format("2006-01-02"), endDate.Format("2006-01-02")).
+		Order("stat_date asc").Find(&snapshotList).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get UserRelationSnapshot list err")
+	}
+
+	return append(ret, snapshotList...), nil
+}
+
+// DeleteUserRelationSnapshotBefore delete the expired snapshots
+func (r *userRelationSnapshotRepo) DeleteUserRelationSnapshotBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	_sql := fmt.Sprintf(_deleteUserRelationSnapshotSQL, _tableUserRelationSnapshotName)
+	result := r.db.WithContext(ctx).Exec(_sql, before.Format("2006-01-02"), limit)
+	if err := result.Error; err != nil {
+		return 0, errors.Wrap(err, "[repo] delete UserRelationSnapshot err")
+	}
+	return result.RowsAffected, nil
+}
+
+// GetTopFollowedUserIDs get the users who have the most followers from the recent snapshots,
+// the follower table is not scanned. The hot users change every day, so they always have recent snapshots.
+func (r *userRelationSnapshotRepo) GetTopFollowedUserIDs(ctx context.Context, since time.Time, limit int) ([]int64, error) {
+	userIDs := make([]int64, 0)
+	_sql := fmt.Sprintf(_getTopFollowedUserSQL, _tableUserRelationSnapshotName)
+	err := r.db.WithContext(ctx).Raw(_sql, since.Format("2006-01-02"), limit).Scan(&userIDs).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "[repo] get top followed user ids err")
+	}
+	return userIDs, nil
+}
+
+// dayRange return the start and end time of the day
+func dayRange(day time.Time) (time.Time, time.Time) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	return start, start.AddDate(0, 0, 1)
+}
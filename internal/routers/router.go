@@ -41,8 +41,8 @@ func NewRouter() *gin.Engine {
 	// see: https://github.com/gin-contrib/pprof
 	// pprof.Register(g)
 
-	// HealthCheck 健康检查路由
-	g.GET("/health", app.HealthCheck)
+	// HealthCheck 健康检查路由, 包含 redis 熔断状态
+	g.GET("/health", handler.Health)
 	// metrics router 可以在 prometheus 中进行监控
 	// 通过 grafana 可视化查看 prometheus 的监控数据，使用插件6671查看
 	g.GET("/metrics", gin.WrapH(promhttp.Handler()))
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	"github.com/go-eagle/eagle/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminMethodPrefix the full method prefix of RelationAdminService
+const adminMethodPrefix = "/relation.v1.RelationAdminService/"
+
+// AdminConfig admin service config, see config/{env}/admin.yaml
+type AdminConfig struct {
+	// Tokens 允许调用管理接口的 token, 为空时拒绝所有管理接口的调用
+	Tokens []string
+}
+
+// LoadAdminConf load admin config, all admin calls are denied if admin.yaml is absent
+func LoadAdminConf() *AdminConfig {
+	v, err := config.LoadWithType("admin", "yaml")
+	if err != nil {
+		log.Warnf("load admin config err: %v, the admin service is disabled", err)
+		return &AdminConfig{}
+	}
+
+	var c AdminConfig
+	if err := v.Unmarshal(&c); err != nil {
+		log.Warnf("unmarshal admin config err: %v, the admin service is disabled", err)
+		return &AdminConfig{}
+	}
+	return &c
+}
+
+// AdminAuthInterceptor check the bearer token of the admin methods, other methods are passed through
+func AdminAuthInterceptor(cfg *AdminConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, adminMethodPrefix) {
+			return handler(ctx, req)
+		}
+
+		token := bearerToken(ctx)
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing admin token")
+		}
+		if !cfg.allow(token) {
+			log.WithContext(ctx).Warnf("[admin] invalid token, method: %s", info.FullMethod)
+			return nil, status.Error(codes.PermissionDenied, "invalid admin token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (c *AdminConfig) allow(token string) bool {
+	return allowToken(c.Tokens, token)
+}
+
+// allowToken whether the token is one of the tokens, compared in constant time
+func allowToken(tokens []string, token string) bool {
+	for _, v := range tokens {
+		if v != "" && subtle.ConstantTimeCompare([]byte(v), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// bearerToken get the token from metadata authorization: Bearer {token}
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+			return strings.TrimSpace(v[7:])
+		}
+	}
+	return ""
+}
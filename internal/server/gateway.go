@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	"github.com/go-eagle/eagle/pkg/log"
+	"google.golang.org/grpc"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/service"
+)
+
+// GatewayConfig the gateways calling RelationService, see config/{env}/gateway.yaml
+type GatewayConfig struct {
+	// WebTokens web 网关的调用凭证, 携带这些 token 的关注和取关记为 web 来源, 其他调用记为 app 来源
+	WebTokens []string
+}
+
+// LoadGatewayConf load gateway config, all calls are from the app if gateway.yaml is absent
+func LoadGatewayConf() *GatewayConfig {
+	v, err := config.LoadWithType("gateway", "yaml")
+	if err != nil {
+		log.Warnf("load gateway config err: %v, all calls are logged as app", err)
+		return &GatewayConfig{}
+	}
+
+	var c GatewayConfig
+	if err := v.Unmarshal(&c); err != nil {
+		log.Warnf("unmarshal gateway config err: %v, all calls are logged as app", err)
+		return &GatewayConfig{}
+	}
+	return &c
+}
+
+// GatewayInterceptor set the source of the relation logs by the token of the gateway,
+// the source declared by the callers is not trusted
+func GatewayInterceptor(cfg *GatewayConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, adminMethodPrefix) {
+			return handler(ctx, req)
+		}
+		if token := bearerToken(ctx); token != "" && allowToken(cfg.WebTokens, token) {
+			ctx = service.WithRelationLogSource(ctx, model.RelationLogSourceWeb)
+		}
+		return handler(ctx, req)
+	}
+}
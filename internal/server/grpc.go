@@ -11,16 +11,20 @@ import (
 )
 
 // NewGRPCServer creates a gRPC server
-func NewGRPCServer(cfg *app.ServerConfig, svc *service.RelationServiceServer) *grpc.Server {
+func NewGRPCServer(cfg *app.ServerConfig, svc *service.RelationServiceServer,
+	adminSvc *service.RelationAdminServiceServer, adminCfg *AdminConfig, gatewayCfg *GatewayConfig) *grpc.Server {
 
 	grpcServer := grpc.NewServer(
 		grpc.Network("tcp"),
 		grpc.Address(cfg.Addr),
 		grpc.Timeout(3*time.Second),
+		grpc.UnaryInterceptor(AdminAuthInterceptor(adminCfg), GatewayInterceptor(gatewayCfg), ValidateInterceptor()),
 	)
 
 	// register biz service
 	v1.RegisterRelationServiceServer(grpcServer, svc)
+	// register admin service, the callers are checked by AdminAuthInterceptor
+	v1.RegisterRelationAdminServiceServer(grpcServer, adminSvc)
 
 	return grpcServer
 }
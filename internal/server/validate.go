@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-eagle/eagle/pkg/errcode"
+	"google.golang.org/grpc"
+
+	"github.com/go-microservice/relation-service/internal/ecode"
+)
+
+// validator the requests generated by protoc-gen-validate, see the rules in api/relation/v1/*.proto
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError a violation of a field, implemented by the generated XxxValidationError
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// multiError the violations of a request, implemented by the generated XxxMultiError
+type multiError interface {
+	AllErrors() []error
+}
+
+// ValidateInterceptor validate the requests by the rules of the proto,
+// ErrInvalidArgument is returned with the reason of each invalid field as details
+func ValidateInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		v, ok := req.(validator)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if err := v.ValidateAll(); err != nil {
+			return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(fieldDetails(req, err))).Status().Err()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// fieldDetails map the proto field name to the reason, the index of a repeated field or the key of a map
+// is kept and the embedded messages are flattened by the path, eg: user_id, ids[2], items[0].user_id
+func fieldDetails(req interface{}, err error) map[string]interface{} {
+	details := make(map[string]interface{})
+	collectFieldErrors(details, "", reflect.TypeOf(req), err)
+	return details
+}
+
+func collectFieldErrors(details map[string]interface{}, prefix string, msgType reflect.Type, err error) {
+	var me multiError
+	if errors.As(err, &me) {
+		for _, e := range me.AllErrors() {
+			collectFieldErrors(details, prefix, msgType, e)
+		}
+		return
+	}
+
+	fe, ok := err.(fieldError)
+	if !ok {
+		key := strings.TrimSuffix(prefix, ".")
+		if key == "" {
+			key = "request"
+		}
+		details[key] = err.Error()
+		return
+	}
+	name, fieldType := protoFieldName(msgType, fe.Field())
+	field := prefix + name
+	// the cause of an embedded message is wrapped by the error of the field
+	if cause := errors.Unwrap(err); cause != nil {
+		collectFieldErrors(details, field+".", fieldType, cause)
+		return
+	}
+	details[field] = fe.Reason()
+}
+
+// protoFieldName convert the go field name reported by protoc-gen-validate to the proto field name
+// by the tag of the generated struct, eg: UserId -> user_id, Ids[2] -> ids[2].
+// The type of the field is returned for its embedded message, the element type for a repeated field or map.
+func protoFieldName(msgType reflect.Type, field string) (string, reflect.Type) {
+	goName, suffix := field, ""
+	if i := strings.IndexByte(field, '['); i >= 0 {
+		goName, suffix = field[:i], field[i:]
+	}
+
+	for msgType != nil && msgType.Kind() == reflect.Ptr {
+		msgType = msgType.Elem()
+	}
+	if msgType == nil || msgType.Kind() != reflect.Struct {
+		return field, nil
+	}
+	sf, ok := msgType.FieldByName(goName)
+	if !ok {
+		// eg: the fields of a oneof are in the wrapper types
+		return field, nil
+	}
+
+	name := goName
+	for _, v := range strings.Split(sf.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(v, "name=") {
+			name = strings.TrimPrefix(v, "name=")
+			break
+		}
+	}
+	fieldType := sf.Type
+	if suffix != "" && (fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Map) {
+		fieldType = fieldType.Elem()
+	}
+	return name + suffix, fieldType
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	v1 "github.com/go-microservice/relation-service/api/relation/v1"
+)
+
+func TestValidateInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		// wantDetails nil means the request is passed to the handler
+		wantDetails map[string]interface{}
+	}{
+		{
+			name: "valid",
+			req:  &v1.FollowRequest{UserId: 1, FollowedUid: 2},
+		},
+		{
+			name: "not a validator",
+			req:  "request",
+		},
+		{
+			name: "all the invalid fields",
+			req:  &v1.FollowRequest{Source: 100},
+			wantDetails: map[string]interface{}{
+				"user_id":      "value must be greater than 0",
+				"followed_uid": "value must be greater than 0",
+				"source":       "value must be one of the defined enum values",
+			},
+		},
+		{
+			name: "repeated item",
+			req:  &v1.BatchGetRelationRequest{UserId: 1, Ids: []int64{2, 3, 0}},
+			wantDetails: map[string]interface{}{
+				"ids[2]": "value must be greater than 0",
+			},
+		},
+		{
+			name: "admin request",
+			req:  &v1.ForceFollowRequest{UserId: 1, FollowedUid: 2},
+			wantDetails: map[string]interface{}{
+				"reason": "value length must be between 1 and 255 runes, inclusive",
+			},
+		},
+		{
+			name: "moved admin request",
+			req:  &v1.RelationHistoryRequest{Action: "block", Limit: 101},
+			wantDetails: map[string]interface{}{
+				"action": `value must be in list [ follow unfollow]`,
+				"limit":  "value must be inside range [1, 100]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return req, nil
+			}
+			_, err := ValidateInterceptor()(context.Background(), tt.req, &grpc.UnaryServerInfo{}, handler)
+
+			if tt.wantDetails == nil {
+				if err != nil || !called {
+					t.Fatalf("ValidateInterceptor() err = %v, handler called %v", err, called)
+				}
+				return
+			}
+			if called {
+				t.Fatal("ValidateInterceptor() handler called for an invalid request")
+			}
+			st, _ := status.FromError(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("ValidateInterceptor() code = %v, want %v", st.Code(), codes.InvalidArgument)
+			}
+			if len(st.Details()) != 1 {
+				t.Fatalf("ValidateInterceptor() details = %v, want 1", st.Details())
+			}
+			details, ok := st.Details()[0].(*structpb.Struct)
+			if !ok {
+				t.Fatalf("ValidateInterceptor() detail = %T, want *structpb.Struct", st.Details()[0])
+			}
+			if got := details.AsMap(); !reflect.DeepEqual(got, tt.wantDetails) {
+				t.Errorf("ValidateInterceptor() details = %v, want %v", got, tt.wantDetails)
+			}
+		})
+	}
+}
+
+// nestedError the error of an embedded message, as the generated XxxValidationError
+type nestedError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+func (e nestedError) Field() string  { return e.field }
+func (e nestedError) Reason() string { return e.reason }
+func (e nestedError) Unwrap() error  { return e.cause }
+func (e nestedError) Error() string  { return e.field + ": " + e.reason }
+
+func TestFieldDetailsEmbedded(t *testing.T) {
+	err := nestedError{
+		field:  "Result[0]",
+		reason: "embedded message failed validation",
+		cause:  nestedError{field: "FollowedUid", reason: "value must be greater than 0"},
+	}
+	want := map[string]interface{}{"result[0].followed_uid": "value must be greater than 0"}
+	if got := fieldDetails(&v1.FollowingListReply{}, err); !reflect.DeepEqual(got, want) {
+		t.Errorf("fieldDetails() = %v, want %v", got, want)
+	}
+
+	// the errors which are not of a field
+	want = map[string]interface{}{"request": "invalid"}
+	if got := fieldDetails(&v1.FollowingListReply{}, errors.New("invalid")); !reflect.DeepEqual(got, want) {
+		t.Errorf("fieldDetails() = %v, want %v", got, want)
+	}
+}
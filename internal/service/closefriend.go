@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// CloseFriendStatusNormal 密友状态-正常
+	CloseFriendStatusNormal int = 1
+	// CloseFriendStatusDelete 密友状态-删除
+	CloseFriendStatusDelete = 0
+)
+
+// AddCloseFriend 添加密友
+func (s *RelationServiceServer) AddCloseFriend(ctx context.Context, req *pb.AddCloseFriendRequest) (*pb.AddCloseFriendReply, error) {
+	if req.GetUserId() == 0 || req.GetFriendUid() == 0 || isSelf(req.GetUserId(), req.GetFriendUid()) {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	// 对方必须关注了自己
+	following, err := s.followingRepo.GetUserFollowing(ctx, req.GetFriendUid(), req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	if following == nil || following.Status != FollowStatusNormal {
+		return nil, ecode.ErrNotFollower.WithDetails().Status(req).Err()
+	}
+
+	curTime := time.Now()
+	_, err = s.closeFriendRepo.CreateUserCloseFriend(ctx, model.GetDB(), &model.UserCloseFriendModel{
+		UserID:    req.GetUserId(),
+		FriendUID: req.GetFriendUid(),
+		Status:    CloseFriendStatusNormal,
+		CreatedAt: curTime,
+		UpdatedAt: curTime,
+	})
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.AddCloseFriendReply{}, nil
+}
+
+// RemoveCloseFriend 移除密友
+func (s *RelationServiceServer) RemoveCloseFriend(ctx context.Context, req *pb.RemoveCloseFriendRequest) (*pb.RemoveCloseFriendReply, error) {
+	if req.GetUserId() == 0 || req.GetFriendUid() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	err := s.closeFriendRepo.UpdateUserCloseFriendStatus(ctx, model.GetDB(), req.GetUserId(), req.GetFriendUid(), CloseFriendStatusDelete)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.RemoveCloseFriendReply{}, nil
+}
+
+// BatchIsCloseFriend 批量判断是否为密友
+func (s *RelationServiceServer) BatchIsCloseFriend(ctx context.Context, req *pb.BatchIsCloseFriendRequest) (*pb.BatchIsCloseFriendReply, error) {
+	if req.GetUserId() == 0 || len(req.GetIds()) == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	ret, err := s.closeFriendRepo.BatchGetUserCloseFriend(ctx, req.GetUserId(), req.GetIds())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	retMap := make(map[int64]bool, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		retMap[id] = false
+	}
+	for _, v := range ret {
+		retMap[v.FriendUID] = true
+	}
+
+	return &pb.BatchIsCloseFriendReply{
+		Result: retMap,
+	}, nil
+}
+
+// ListCloseFriends 密友列表
+func (s *RelationServiceServer) ListCloseFriends(ctx context.Context, req *pb.ListCloseFriendsRequest) (*pb.ListCloseFriendsReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	if req.GetLastId() == 0 {
+		req.LastId = MaxID
+	}
+
+	closeFriends, err := s.closeFriendRepo.GetCloseFriendList(ctx, req.GetUserId(), req.GetLastId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	var data []*pb.ListCloseFriendsReplyCloseFriend
+	for _, v := range closeFriends {
+		data = append(data, &pb.ListCloseFriendsReplyCloseFriend{
+			Id:        v.ID,
+			FriendUid: v.FriendUID,
+		})
+	}
+
+	return &pb.ListCloseFriendsReply{
+		Result: data,
+	}, nil
+}
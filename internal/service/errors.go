@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-eagle/eagle/pkg/errcode"
+	"github.com/go-eagle/eagle/pkg/log"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/quota"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+// errorStatus map an error of the repos to the ecode returned to the client.
+// The error text may contain SQL or the addresses of DB and redis, so the unknown errors are
+// logged with the method and returned as ErrInternalError without details.
+func errorStatus(ctx context.Context, err error) *errcode.GrpcStatus {
+	switch {
+	case errors.Is(err, quota.ErrLimitExceeded):
+		return ecode.ErrFollowLimitExceeded.WithDetails()
+	case errors.Is(err, cache.ErrFallbackBusy):
+		// the cache is unavailable and too many requests are reading DB
+		return ecode.ErrRateLimited.WithDetails()
+	case errors.Is(err, usercheck.ErrUserNotFound):
+		return ecode.ErrTargetNotFound.WithDetails()
+	case errors.Is(err, usercheck.ErrUserUnavailable):
+		return ecode.ErrTargetUnavailable.WithDetails()
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ecode.ErrNotFound.WithDetails()
+	}
+
+	method, _ := grpc.Method(ctx)
+	log.WithContext(ctx).Errorf("[service] %s err: %+v", method, err)
+	return ecode.ErrInternalError.WithDetails()
+}
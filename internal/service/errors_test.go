@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	"github.com/go-eagle/eagle/pkg/errcode"
+	logger "github.com/go-eagle/eagle/pkg/log"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/quota"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+func TestMain(m *testing.M) {
+	config.New("../../config", config.WithEnv("dev"))
+	logger.Init()
+	os.Exit(m.Run())
+}
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want *ecode.Error
+	}{
+		{name: "limit exceeded", err: quota.ErrLimitExceeded, want: ecode.ErrFollowLimitExceeded},
+		{name: "fallback busy", err: cache.ErrFallbackBusy, want: ecode.ErrRateLimited},
+		{name: "user not found", err: usercheck.ErrUserNotFound, want: ecode.ErrTargetNotFound},
+		{name: "user unavailable", err: usercheck.ErrUserUnavailable, want: ecode.ErrTargetUnavailable},
+		{name: "record not found", err: gorm.ErrRecordNotFound, want: ecode.ErrNotFound},
+		{name: "wrapped", err: fmt.Errorf("follow: %w", quota.ErrLimitExceeded), want: ecode.ErrFollowLimitExceeded},
+		{name: "unknown", err: errors.New("dial tcp 10.0.0.1:3306: connection refused"), want: ecode.ErrInternalError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := errorStatus(context.Background(), tt.err).Status()
+			if st.Code() != tt.want.Code() || st.Message() != tt.want.Message() {
+				t.Errorf("errorStatus() = %d %q, want %d %q", st.Code(), st.Message(), tt.want.Code(), tt.want.Message())
+			}
+			// the text of the error is never returned to the client
+			if len(st.Details()) != 0 {
+				t.Errorf("errorStatus() details = %v, want none", st.Details())
+			}
+		})
+	}
+}
+
+func TestErrorStatusNotShared(t *testing.T) {
+	withDetails := ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{"user_id": "value must be greater than 0"}))
+	if got := len(withDetails.Status().Details()); got != 1 {
+		t.Fatalf("details = %d, want 1", got)
+	}
+	// the details of a request are not returned by the others
+	if got := ecode.ErrInvalidArgument.Status().Details(); len(got) != 0 {
+		t.Errorf("details = %v, want none", got)
+	}
+	if st := ecode.ErrInvalidArgument.Status(); st.Code() != codes.InvalidArgument {
+		t.Errorf("code = %d, want %d", st.Code(), codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/errcode"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// MaxFollowerTimeSeriesDays 单次最多查询的天数
+	MaxFollowerTimeSeriesDays = 3 * 366
+)
+
+// GetFollowerTimeSeries 用户粉丝数的时间序列
+func (s *RelationServiceServer) GetFollowerTimeSeries(ctx context.Context, req *pb.FollowerTimeSeriesRequest) (*pb.FollowerTimeSeriesReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	from, err := time.ParseInLocation("2006-01-02", req.GetFrom(), time.Local)
+	if err != nil {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid from",
+		})).Status(req).Err()
+	}
+	to, err := time.ParseInLocation("2006-01-02", req.GetTo(), time.Local)
+	if err != nil {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid to",
+		})).Status(req).Err()
+	}
+	if to.Before(from) || to.Sub(from) > MaxFollowerTimeSeriesDays*24*time.Hour {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid date range",
+		})).Status(req).Err()
+	}
+	granularity := req.GetGranularity()
+	if _, ok := pb.TimeSeriesGranularity_name[int32(granularity)]; !ok {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid granularity",
+		})).Status(req).Err()
+	}
+
+	snapshots, err := s.snapshotRepo.GetUserRelationSnapshotList(ctx, req.GetUserId(), from, to)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// base is the value before the first point
+	var base model.UserRelationSnapshotModel
+	switch {
+	case len(snapshots) > 0 && snapshots[0].StatDate.Before(from):
+		base = *snapshots[0]
+		snapshots = snapshots[1:]
+	case len(snapshots) > 0:
+		// no snapshot before from, derive it from the first change in the range
+		followingChange, err := s.snapshotRepo.GetUserFollowingChange(ctx, req.GetUserId(), snapshots[0].StatDate)
+		if err != nil {
+			return nil, errorStatus(ctx, err).Status(req).Err()
+		}
+		base.FollowerCount = snapshots[0].FollowerCount - snapshots[0].FollowerGain + snapshots[0].FollowerLoss
+		base.FollowingCount = snapshots[0].FollowingCount - followingChange
+	default:
+		// nothing changed within the retention, the counts at the end of to are used as a flat line,
+		// they are rewound from the current counts by the changes after to
+		last, err := s.snapshotRepo.BuildUserRelationSnapshot(ctx, to, []int64{req.GetUserId()})
+		if err != nil {
+			return nil, errorStatus(ctx, err).Status(req).Err()
+		}
+		base.FollowerCount = last[0].FollowerCount
+		base.FollowingCount = last[0].FollowingCount
+	}
+
+	var data []*pb.FollowerTimeSeriesReplyPoint
+	end := to.AddDate(0, 0, 1)
+	for start := periodStart(from, granularity); start.Before(end); start = nextPeriod(start, granularity) {
+		next := nextPeriod(start, granularity)
+		point := &pb.FollowerTimeSeriesReplyPoint{
+			Date:           start.Format("2006-01-02"),
+			FollowerCount:  base.FollowerCount,
+			FollowingCount: base.FollowingCount,
+		}
+		for len(snapshots) > 0 && snapshots[0].StatDate.Before(next) {
+			point.FollowerCount = snapshots[0].FollowerCount
+			point.FollowingCount = snapshots[0].FollowingCount
+			point.FollowerGain += snapshots[0].FollowerGain
+			point.FollowerLoss += snapshots[0].FollowerLoss
+			snapshots = snapshots[1:]
+		}
+		base.FollowerCount = point.FollowerCount
+		base.FollowingCount = point.FollowingCount
+		data = append(data, point)
+	}
+
+	return &pb.FollowerTimeSeriesReply{
+		Result: data,
+	}, nil
+}
+
+// periodStart return the first day of the period which the day belongs to
+func periodStart(day time.Time, granularity pb.TimeSeriesGranularity) time.Time {
+	switch granularity {
+	case pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_WEEK:
+		// Monday is the first day of a week
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_MONTH:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	default:
+		return day
+	}
+}
+
+// nextPeriod return the first day of the next period
+func nextPeriod(start time.Time, granularity pb.TimeSeriesGranularity) time.Time {
+	switch granularity {
+	case pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_WEEK:
+		return start.AddDate(0, 0, 7)
+	case pb.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_MONTH:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/errcode"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+)
+
+const (
+	// MaxSourceMetaLen 关注来源附加信息的最大长度
+	MaxSourceMetaLen = 512
+	// MaxFollowSourceStatDays 单次最多查询的天数
+	MaxFollowSourceStatDays = 366
+)
+
+// GetFollowSourceStats 按来源统计的每日关注/取关数
+func (s *RelationServiceServer) GetFollowSourceStats(ctx context.Context, req *pb.FollowSourceStatsRequest) (*pb.FollowSourceStatsReply, error) {
+	startDate, err := time.ParseInLocation("2006-01-02", req.GetStartDate(), time.Local)
+	if err != nil {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid start_date",
+		})).Status(req).Err()
+	}
+	endDate, err := time.ParseInLocation("2006-01-02", req.GetEndDate(), time.Local)
+	if err != nil {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid end_date",
+		})).Status(req).Err()
+	}
+	if endDate.Before(startDate) || endDate.Sub(startDate) > MaxFollowSourceStatDays*24*time.Hour {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "invalid date range",
+		})).Status(req).Err()
+	}
+
+	source := -1
+	if req.Source != nil {
+		source = int(req.GetSource())
+	}
+	stats, err := s.sourceStatRepo.GetFollowSourceStatList(ctx, startDate, endDate, source)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	var data []*pb.FollowSourceStatsReplyStat
+	for _, v := range stats {
+		data = append(data, &pb.FollowSourceStatsReplyStat{
+			Date:          v.StatDate.Format("2006-01-02"),
+			Source:        pb.FollowSource(v.Source),
+			FollowCount:   v.FollowCount,
+			UnfollowCount: v.UnfollowCount,
+		})
+	}
+
+	return &pb.FollowSourceStatsReply{
+		Result: data,
+	}, nil
+}
+
+// encodeSourceMeta encode the source meta to json for storage
+func encodeSourceMeta(meta map[string]string) (string, error) {
+	if len(meta) == 0 {
+		return "", nil
+	}
+	buf, err := json.Marshal(meta)
+	if err != nil {
+		return "", err
+	}
+	if len(buf) > MaxSourceMetaLen {
+		return "", errors.New("source meta is too long")
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+)
+
+const (
+	// DefaultTopGrowingLimit 增长排行默认返回数
+	DefaultTopGrowingLimit = 20
+	// MaxTopGrowingLimit 增长排行最大返回数
+	MaxTopGrowingLimit = 100
+)
+
+var growthWindows = map[pb.GrowthWindow]time.Duration{
+	pb.GrowthWindow_GROWTH_WINDOW_UNSPECIFIED: 24 * time.Hour,
+	pb.GrowthWindow_GROWTH_WINDOW_HOUR:        time.Hour,
+	pb.GrowthWindow_GROWTH_WINDOW_DAY:         24 * time.Hour,
+	pb.GrowthWindow_GROWTH_WINDOW_WEEK:        7 * 24 * time.Hour,
+}
+
+// GetTopGrowingAccounts 粉丝增长最快的用户
+func (s *RelationServiceServer) GetTopGrowingAccounts(ctx context.Context, req *pb.TopGrowingAccountsRequest) (*pb.TopGrowingAccountsReply, error) {
+	window, ok := growthWindows[req.GetWindow()]
+	if !ok {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = DefaultTopGrowingLimit
+	}
+	if limit > MaxTopGrowingLimit {
+		limit = MaxTopGrowingLimit
+	}
+
+	accounts, err := s.growthRepo.GetTopGrowingAccounts(ctx, window, limit)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	var data []*pb.TopGrowingAccountsReplyAccount
+	for _, v := range accounts {
+		data = append(data, &pb.TopGrowingAccountsReplyAccount{
+			Uid:    v.UID,
+			Growth: v.Growth,
+		})
+	}
+
+	return &pb.TopGrowingAccountsReply{
+		Result: data,
+	}, nil
+}
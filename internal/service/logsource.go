@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+type relationLogSourceKey struct{}
+
+// WithRelationLogSource set the source of the relation logs written by the request,
+// it is set by the server from the caller, eg: web for the web gateway
+func WithRelationLogSource(ctx context.Context, source string) context.Context {
+	return context.WithValue(ctx, relationLogSourceKey{}, source)
+}
+
+// relationLogSource the source of the relation logs, app by default
+func relationLogSource(ctx context.Context) string {
+	if source, ok := ctx.Value(relationLogSourceKey{}).(string); ok && source != "" {
+		return source
+	}
+	return model.RelationLogSourceApp
+}
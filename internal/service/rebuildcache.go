@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+)
+
+// RebuildUserCache 重建用户的关注/粉丝缓存
+func (s *RelationAdminServiceServer) RebuildUserCache(ctx context.Context, req *pb.RebuildUserCacheRequest) (*pb.RebuildUserCacheReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	followingCount, err := s.followingRepo.WarmUserFollowingCache(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	followerCount, err := s.followerRepo.WarmUserFollowerCache(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.RebuildUserCacheReply{
+		FollowingCount: int64(followingCount),
+		FollowerCount:  int64(followerCount),
+	}, nil
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/errcode"
+	"github.com/go-eagle/eagle/pkg/log"
+	"github.com/hibiken/asynq"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/lifecycle"
+	"github.com/go-microservice/relation-service/internal/model"
+	repo "github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/tasks"
+)
+
+var (
+	_ pb.RelationAdminServiceServer = (*RelationAdminServiceServer)(nil)
+)
+
+// RelationAdminServiceServer the operations of the operators, the caller is checked by the admin auth interceptor
+type RelationAdminServiceServer struct {
+	pb.UnimplementedRelationAdminServiceServer
+
+	followerRepo    repo.UserFollowerRepo
+	followingRepo   repo.UserFollowingRepo
+	suggestionRepo  repo.FollowSuggestionRepo
+	closeFriendRepo repo.UserCloseFriendRepo
+	snapshotRepo    repo.UserRelationSnapshotRepo
+	mergeRepo       repo.UserRelationMergeRepo
+	relationLogRepo repo.RelationLogRepo
+	invalidator     *repo.CacheInvalidator
+	taskClient      *asynq.Client
+}
+
+func NewRelationAdminServiceServer(followerRepo repo.UserFollowerRepo, followingRepo repo.UserFollowingRepo,
+	suggestionRepo repo.FollowSuggestionRepo, closeFriendRepo repo.UserCloseFriendRepo,
+	snapshotRepo repo.UserRelationSnapshotRepo, mergeRepo repo.UserRelationMergeRepo, relationLogRepo repo.RelationLogRepo,
+	invalidator *repo.CacheInvalidator, taskClient *asynq.Client) *RelationAdminServiceServer {
+	return &RelationAdminServiceServer{
+		followerRepo:    followerRepo,
+		followingRepo:   followingRepo,
+		suggestionRepo:  suggestionRepo,
+		closeFriendRepo: closeFriendRepo,
+		snapshotRepo:    snapshotRepo,
+		mergeRepo:       mergeRepo,
+		relationLogRepo: relationLogRepo,
+		invalidator:     invalidator,
+		taskClient:      taskClient,
+	}
+}
+
+// GetRawEdges read both directions from DB, the caches are bypassed
+func (s *RelationAdminServiceServer) GetRawEdges(ctx context.Context, req *pb.GetRawEdgesRequest) (*pb.GetRawEdgesReply, error) {
+	if req.GetUserId() == 0 || req.GetTargetUid() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	reply := &pb.GetRawEdgesReply{
+		Following: make([]*pb.RawFollowingEdge, 0, 2),
+		Follower:  make([]*pb.RawFollowerEdge, 0, 2),
+	}
+	pairs := [][2]int64{
+		{req.GetUserId(), req.GetTargetUid()},
+		{req.GetTargetUid(), req.GetUserId()},
+	}
+	for _, pair := range pairs {
+		following, err := s.followingRepo.GetUserFollowingWithoutCache(ctx, pair[0], pair[1])
+		if err != nil {
+			return nil, errorStatus(ctx, err).Status(req).Err()
+		}
+		if following != nil && following.ID > 0 {
+			reply.Following = append(reply.Following, convertRawFollowingEdge(following))
+		}
+
+		// the follower row of the same edge
+		follower, err := s.followerRepo.GetUserFollowerWithoutCache(ctx, pair[1], pair[0])
+		if err != nil {
+			return nil, errorStatus(ctx, err).Status(req).Err()
+		}
+		if follower != nil && follower.ID > 0 {
+			reply.Follower = append(reply.Follower, convertRawFollowerEdge(follower))
+		}
+	}
+
+	return reply, nil
+}
+
+// ForceFollow write both tables even if they are inconsistent
+func (s *RelationAdminServiceServer) ForceFollow(ctx context.Context, req *pb.ForceFollowRequest) (*pb.ForceFollowReply, error) {
+	if err := s.forceSetStatus(ctx, req.GetUserId(), req.GetFollowedUid(), FollowStatusNormal); err != nil {
+		return nil, forceError(ctx, err).Status(req).Err()
+	}
+	log.WithContext(ctx).Infof("[admin] force follow, user_id: %d, followed_uid: %d, reason: %s",
+		req.GetUserId(), req.GetFollowedUid(), req.GetReason())
+	return &pb.ForceFollowReply{}, nil
+}
+
+// ForceUnfollow write both tables even if they are inconsistent
+func (s *RelationAdminServiceServer) ForceUnfollow(ctx context.Context, req *pb.ForceUnfollowRequest) (*pb.ForceUnfollowReply, error) {
+	if err := s.forceSetStatus(ctx, req.GetUserId(), req.GetFollowedUid(), FollowStatusDelete); err != nil {
+		return nil, forceError(ctx, err).Status(req).Err()
+	}
+	log.WithContext(ctx).Infof("[admin] force unfollow, user_id: %d, followed_uid: %d, reason: %s",
+		req.GetUserId(), req.GetFollowedUid(), req.GetReason())
+	return &pb.ForceUnfollowReply{}, nil
+}
+
+// RecomputeUserCounters overwrite today's snapshot with the counts of the tables
+func (s *RelationAdminServiceServer) RecomputeUserCounters(ctx context.Context, req *pb.RecomputeUserCountersRequest) (*pb.RecomputeUserCountersReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	snapshots, err := s.snapshotRepo.RefreshUserRelationSnapshot(ctx, time.Now(), []int64{req.GetUserId()})
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	reply := &pb.RecomputeUserCountersReply{}
+	if len(snapshots) > 0 {
+		reply.FollowingCount = snapshots[0].FollowingCount
+		reply.FollowerCount = snapshots[0].FollowerCount
+	}
+	return reply, nil
+}
+
+// PurgeUserCache delete the relation caches of the user, they are loaded from DB on the next read
+func (s *RelationAdminServiceServer) PurgeUserCache(ctx context.Context, req *pb.PurgeUserCacheRequest) (*pb.PurgeUserCacheReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	followingCount, err := s.followingRepo.PurgeUserFollowingCache(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	followerCount, err := s.followerRepo.PurgeUserFollowerCache(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	closeFriendCount, err := s.closeFriendRepo.PurgeUserCloseFriendCache(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	if err := s.suggestionRepo.PurgeFollowSuggestions(ctx, req.GetUserId()); err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	log.WithContext(ctx).Infof("[admin] purge user cache, user_id: %d", req.GetUserId())
+	return &pb.PurgeUserCacheReply{
+		FollowingCount:   int64(followingCount),
+		FollowerCount:    int64(followerCount),
+		CloseFriendCount: int64(closeFriendCount),
+	}, nil
+}
+
+// MergeUserRelations create a merge record and enqueue the merge task, the task resumes from the cursor
+// of the record when it is retried. An unfinished merge of the same users is enqueued again instead
+// of creating a new one, so the call can be retried if the enqueue fails.
+func (s *RelationAdminServiceServer) MergeUserRelations(ctx context.Context, req *pb.MergeUserRelationsRequest) (*pb.MergeUserRelationsReply, error) {
+	if req.GetFromUid() == 0 || req.GetToUid() == 0 || isSelf(req.GetFromUid(), req.GetToUid()) {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "from_uid and to_uid are required and must be different",
+		})).Status(req).Err()
+	}
+
+	merge, err := s.mergeRepo.GetUnfinishedUserRelationMerge(ctx, req.GetFromUid(), req.GetToUid())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	if merge == nil {
+		curTime := time.Now()
+		merge = &model.UserRelationMergeModel{
+			FromUID:   req.GetFromUid(),
+			ToUID:     req.GetToUid(),
+			Status:    model.UserRelationMergeStatusPending,
+			Phase:     lifecycle.PhaseFollowing,
+			Reason:    req.GetReason(),
+			CreatedAt: curTime,
+			UpdatedAt: curTime,
+		}
+		if _, err := s.mergeRepo.CreateUserRelationMerge(ctx, merge); err != nil {
+			return nil, errorStatus(ctx, err).Status(req).Err()
+		}
+	}
+
+	task, err := tasks.NewUserRelationMergeTask(merge.ID)
+	if err == nil {
+		_, err = s.taskClient.EnqueueContext(ctx, task, tasks.UserRelationMergeOpts(merge.ID)...)
+	}
+	// the task of the merge is waiting or running
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	log.WithContext(ctx).Infof("[admin] merge user relations, merge_id: %d, from_uid: %d, to_uid: %d, reason: %s",
+		merge.ID, req.GetFromUid(), req.GetToUid(), req.GetReason())
+	return &pb.MergeUserRelationsReply{MergeId: merge.ID}, nil
+}
+
+// GetUserRelationMerge get the progress of a merge
+func (s *RelationAdminServiceServer) GetUserRelationMerge(ctx context.Context, req *pb.GetUserRelationMergeRequest) (*pb.GetUserRelationMergeReply, error) {
+	if req.GetMergeId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	merge, err := s.mergeRepo.GetUserRelationMerge(ctx, req.GetMergeId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	if merge == nil {
+		return nil, ecode.ErrNotFound.WithDetails().Status(req).Err()
+	}
+	return &pb.GetUserRelationMergeReply{Merge: convertUserRelationMerge(merge)}, nil
+}
+
+var errInvalidEdge = errors.New("user_id and followed_uid are required and must be different")
+
+// forceSetStatus write the edge into both tables in a transaction, the caches are deleted after commit.
+// A relation log of the admin source is written if the status of the following table is changed.
+func (s *RelationAdminServiceServer) forceSetStatus(ctx context.Context, userID, followedUID int64, status int) error {
+	if userID == 0 || followedUID == 0 || isSelf(userID, followedUID) {
+		return errInvalidEdge
+	}
+	old, err := s.followingRepo.GetUserFollowingWithoutCache(ctx, userID, followedUID)
+	if err != nil {
+		return err
+	}
+	action := model.RelationLogActionFollow
+	if status == FollowStatusDelete {
+		action = model.RelationLogActionUnfollow
+	}
+	// the status of the missing row is FollowStatusDelete
+	changed := old.Status != status
+
+	ctx, invalidation := s.invalidator.Begin(ctx)
+	tx := model.GetDB().Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	curTime := time.Now()
+	if status == FollowStatusDelete {
+		// only the existing rows are updated
+		err = s.followingRepo.UpdateUserFollowingStatus(ctx, tx, userID, followedUID, status)
+		if err == nil {
+			err = s.followerRepo.UpdateUserFollowerStatus(ctx, tx, followedUID, userID, status)
+		}
+	} else {
+		// the upsert creates the missing row of either table, the attributes of the existing row are kept
+		err = s.followingRepo.UpsertUserFollowingStatus(ctx, tx, &model.UserFollowingModel{
+			UserID:      userID,
+			FollowedUID: followedUID,
+			Status:      status,
+			CreatedAt:   curTime,
+			UpdatedAt:   curTime,
+		})
+		if err == nil {
+			_, err = s.followerRepo.CreateUserFollower(ctx, tx, &model.UserFollowerModel{
+				UserID:      followedUID,
+				FollowerUID: userID,
+				Status:      status,
+				CreatedAt:   curTime,
+				UpdatedAt:   curTime,
+			})
+		}
+	}
+	if err == nil && changed {
+		_, err = s.relationLogRepo.CreateRelationLog(ctx, tx, &model.RelationLogModel{
+			UserID:       userID,
+			TargetUID:    followedUID,
+			Action:       action,
+			Source:       model.RelationLogSourceAdmin,
+			FollowSource: old.Source,
+			CreatedAt:    curTime,
+		})
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	invalidation.Commit(ctx)
+	return nil
+}
+
+// forceError the edge error is caused by the request, others are internal
+func forceError(ctx context.Context, err error) *errcode.GrpcStatus {
+	if errors.Is(err, errInvalidEdge) {
+		return ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": err.Error(),
+		}))
+	}
+	return errorStatus(ctx, err)
+}
+
+func convertRawFollowingEdge(v *model.UserFollowingModel) *pb.RawFollowingEdge {
+	return &pb.RawFollowingEdge{
+		Id:          v.ID,
+		UserId:      v.UserID,
+		FollowedUid: v.FollowedUID,
+		Status:      int32(v.Status),
+		Remark:      v.Remark,
+		IsSpecial:   v.IsSpecial,
+		IsMuted:     v.IsMuted,
+		Source:      int32(v.Source),
+		SourceMeta:  v.SourceMeta,
+		CreatedAt:   v.CreatedAt.Unix(),
+		UpdatedAt:   v.UpdatedAt.Unix(),
+	}
+}
+
+func convertUserRelationMerge(v *model.UserRelationMergeModel) *pb.UserRelationMerge {
+	ret := &pb.UserRelationMerge{
+		Id:          v.ID,
+		FromUid:     v.FromUID,
+		ToUid:       v.ToUID,
+		Status:      int32(v.Status),
+		Phase:       v.Phase,
+		Removed:     v.Removed,
+		Transferred: v.Transferred,
+		Duplicates:  v.Duplicates,
+		OverLimit:   v.OverLimit,
+		Unavailable: v.Unavailable,
+		Reason:      v.Reason,
+		Error:       v.Error,
+		CreatedAt:   v.CreatedAt.Unix(),
+		UpdatedAt:   v.UpdatedAt.Unix(),
+	}
+	if v.FinishedAt != nil {
+		ret.FinishedAt = v.FinishedAt.Unix()
+	}
+	return ret
+}
+
+func convertRawFollowerEdge(v *model.UserFollowerModel) *pb.RawFollowerEdge {
+	return &pb.RawFollowerEdge{
+		Id:          v.ID,
+		UserId:      v.UserID,
+		FollowerUid: v.FollowerUID,
+		Status:      int32(v.Status),
+		CreatedAt:   v.CreatedAt.Unix(),
+		UpdatedAt:   v.UpdatedAt.Unix(),
+	}
+}
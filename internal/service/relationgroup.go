@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-eagle/eagle/pkg/errcode"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/model"
+)
+
+const (
+	// GroupStatusNormal 分组状态-正常
+	GroupStatusNormal int = 1
+	// GroupStatusDelete 分组状态-删除
+	GroupStatusDelete = 0
+)
+
+// CreateRelationGroup 创建分组
+func (s *RelationServiceServer) CreateRelationGroup(ctx context.Context, req *pb.CreateRelationGroupRequest) (*pb.CreateRelationGroupReply, error) {
+	name := strings.TrimSpace(req.GetName())
+	if req.GetUserId() == 0 || !isValidGroupName(name) {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	groups, err := s.groupRepo.GetRelationGroupList(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	if len(groups) >= MaxRelationGroupNum {
+		return nil, ecode.ErrRelationGroupExceeded.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"limit": MaxRelationGroupNum,
+		})).Status(req).Err()
+	}
+
+	curTime := time.Now()
+	group := &model.RelationGroupModel{
+		UserID:    req.GetUserId(),
+		Name:      name,
+		Status:    GroupStatusNormal,
+		CreatedAt: curTime,
+		UpdatedAt: curTime,
+	}
+	_, err = s.groupRepo.CreateRelationGroup(ctx, group)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.CreateRelationGroupReply{
+		Group: convertRelationGroup(group),
+	}, nil
+}
+
+// UpdateRelationGroup 修改分组名
+func (s *RelationServiceServer) UpdateRelationGroup(ctx context.Context, req *pb.UpdateRelationGroupRequest) (*pb.UpdateRelationGroupReply, error) {
+	name := strings.TrimSpace(req.GetName())
+	if req.GetUserId() == 0 || req.GetGroupId() == 0 || !isValidGroupName(name) {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	if _, err := s.getRelationGroup(ctx, req.GetUserId(), req.GetGroupId()); err != nil {
+		return nil, err
+	}
+
+	err := s.groupRepo.UpdateRelationGroupName(ctx, req.GetUserId(), req.GetGroupId(), name)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.UpdateRelationGroupReply{}, nil
+}
+
+// DeleteRelationGroup 删除分组
+func (s *RelationServiceServer) DeleteRelationGroup(ctx context.Context, req *pb.DeleteRelationGroupRequest) (*pb.DeleteRelationGroupReply, error) {
+	if req.GetUserId() == 0 || req.GetGroupId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	if _, err := s.getRelationGroup(ctx, req.GetUserId(), req.GetGroupId()); err != nil {
+		return nil, err
+	}
+
+	db := model.GetDB()
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, errorStatus(ctx, tx.Error).Status(req).Err()
+	}
+	err := s.groupRepo.DeleteRelationGroup(ctx, tx, req.GetUserId(), req.GetGroupId())
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	err = s.groupMemberRepo.DeleteGroupMemberByGroup(ctx, tx, req.GetGroupId())
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	err = tx.Commit().Error
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.DeleteRelationGroupReply{}, nil
+}
+
+// ListRelationGroups 分组列表
+func (s *RelationServiceServer) ListRelationGroups(ctx context.Context, req *pb.ListRelationGroupsRequest) (*pb.ListRelationGroupsReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	groups, err := s.groupRepo.GetRelationGroupList(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	var data []*pb.RelationGroup
+	for _, v := range groups {
+		data = append(data, convertRelationGroup(v))
+	}
+
+	return &pb.ListRelationGroupsReply{
+		Result: data,
+	}, nil
+}
+
+// AddGroupMembers 添加分组成员
+func (s *RelationServiceServer) AddGroupMembers(ctx context.Context, req *pb.AddGroupMembersRequest) (*pb.AddGroupMembersReply, error) {
+	if req.GetUserId() == 0 || req.GetGroupId() == 0 || len(req.GetUids()) == 0 || len(req.GetUids()) > MaxGroupMembersBatch {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	if _, err := s.getRelationGroup(ctx, req.GetUserId(), req.GetGroupId()); err != nil {
+		return nil, err
+	}
+
+	// 只能添加已关注的用户
+	followings, err := s.followingRepo.BatchGetUserFollowing(ctx, req.GetUserId(), req.GetUids())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	uids := make([]int64, 0, len(followings))
+	for _, v := range followings {
+		uids = append(uids, v.FollowedUID)
+	}
+
+	err = s.groupMemberRepo.BatchCreateGroupMember(ctx, model.GetDB(), req.GetGroupId(), req.GetUserId(), uids)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.AddGroupMembersReply{
+		Uids: uids,
+	}, nil
+}
+
+// RemoveGroupMembers 移除分组成员
+func (s *RelationServiceServer) RemoveGroupMembers(ctx context.Context, req *pb.RemoveGroupMembersRequest) (*pb.RemoveGroupMembersReply, error) {
+	if req.GetUserId() == 0 || req.GetGroupId() == 0 || len(req.GetUids()) == 0 || len(req.GetUids()) > MaxGroupMembersBatch {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	if _, err := s.getRelationGroup(ctx, req.GetUserId(), req.GetGroupId()); err != nil {
+		return nil, err
+	}
+
+	err := s.groupMemberRepo.BatchDeleteGroupMember(ctx, model.GetDB(), req.GetGroupId(), req.GetUids())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.RemoveGroupMembersReply{}, nil
+}
+
+// GetGroupMembers 分组成员列表
+func (s *RelationServiceServer) GetGroupMembers(ctx context.Context, req *pb.GroupMembersRequest) (*pb.GroupMembersReply, error) {
+	if req.GetUserId() == 0 || req.GetGroupId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	if req.GetLastId() == 0 {
+		req.LastId = MaxID
+	}
+
+	if _, err := s.getRelationGroup(ctx, req.GetUserId(), req.GetGroupId()); err != nil {
+		return nil, err
+	}
+
+	members, err := s.groupMemberRepo.GetGroupMemberList(ctx, req.GetGroupId(), req.GetLastId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	var data []*pb.GroupMembersReplyMember
+	for _, v := range members {
+		data = append(data, &pb.GroupMembersReplyMember{
+			Id:          v.ID,
+			FollowedUid: v.FollowedUID,
+		})
+	}
+
+	return &pb.GroupMembersReply{
+		Result: data,
+	}, nil
+}
+
+// getRelationGroup get the group and check the owner
+func (s *RelationServiceServer) getRelationGroup(ctx context.Context, userID, groupID int64) (*model.RelationGroupModel, error) {
+	group, err := s.groupRepo.GetRelationGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status().Err()
+	}
+	if group == nil {
+		return nil, ecode.ErrNotFound.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": "relation group not found",
+		})).Status().Err()
+	}
+	return group, nil
+}
+
+func isValidGroupName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= MaxRelationGroupNameLen
+}
+
+func convertRelationGroup(group *model.RelationGroupModel) *pb.RelationGroup {
+	return &pb.RelationGroup{
+		Id:        group.ID,
+		UserId:    group.UserID,
+		Name:      group.Name,
+		CreatedAt: group.CreatedAt.Unix(),
+		UpdatedAt: group.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/go-microservice/relation-service/api/relation/v1"
+	"github.com/go-microservice/relation-service/internal/ecode"
+	repo "github.com/go-microservice/relation-service/internal/repository"
+)
+
+// GetRelationHistory 关系变更历史
+func (s *RelationAdminServiceServer) GetRelationHistory(ctx context.Context, req *pb.RelationHistoryRequest) (*pb.RelationHistoryReply, error) {
+	// 至少需要指定一方, 避免扫全表
+	if req.GetUserId() == 0 && req.GetTargetUid() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	if req.GetLastId() == 0 {
+		req.LastId = MaxID
+	}
+
+	filter := &repo.RelationLogFilter{
+		UserID:    req.GetUserId(),
+		TargetUID: req.GetTargetUid(),
+		Action:    req.GetAction(),
+		Source:    req.GetSource(),
+	}
+	if req.GetStartTime() > 0 {
+		filter.StartTime = time.Unix(req.GetStartTime(), 0)
+	}
+	if req.GetEndTime() > 0 {
+		filter.EndTime = time.Unix(req.GetEndTime(), 0)
+	}
+
+	logs, err := s.relationLogRepo.GetRelationLogList(ctx, filter, req.GetLastId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	var data []*pb.RelationHistoryReplyRelationLog
+	for _, v := range logs {
+		data = append(data, &pb.RelationHistoryReplyRelationLog{
+			Id:        v.ID,
+			UserId:    v.UserID,
+			TargetUid: v.TargetUID,
+			Action:    v.Action,
+			Source:    v.Source,
+			CreatedAt: v.CreatedAt.Unix(),
+		})
+	}
+
+	return &pb.RelationHistoryReply{
+		Result: data,
+	}, nil
+}
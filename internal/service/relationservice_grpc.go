@@ -3,14 +3,20 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-eagle/eagle/pkg/errcode"
+	"github.com/go-eagle/eagle/pkg/log"
 
 	pb "github.com/go-microservice/relation-service/api/relation/v1"
 	"github.com/go-microservice/relation-service/internal/ecode"
+	"github.com/go-microservice/relation-service/internal/event"
 	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/quota"
 	repo "github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
 )
 
 const (
@@ -27,14 +33,46 @@ var (
 type RelationServiceServer struct {
 	pb.UnimplementedRelationServiceServer
 
-	followerRepo  repo.UserFollowerRepo
-	followingRepo repo.UserFollowingRepo
+	followerRepo    repo.UserFollowerRepo
+	followingRepo   repo.UserFollowingRepo
+	suggestionRepo  repo.FollowSuggestionRepo
+	groupRepo       repo.RelationGroupRepo
+	groupMemberRepo repo.RelationGroupMemberRepo
+	closeFriendRepo repo.UserCloseFriendRepo
+	relationLogRepo repo.RelationLogRepo
+	sourceStatRepo  repo.FollowSourceStatRepo
+	growthRepo      repo.GrowthLeaderboardRepo
+	snapshotRepo    repo.UserRelationSnapshotRepo
+	blockRepo       repo.UserBlockRepo
+	invalidator     *repo.CacheInvalidator
+	publisher       event.Publisher
+	quotaChecker    *quota.Checker
+	userChecker     usercheck.UserChecker
 }
 
-func NewRelationServiceServer(followerRepo repo.UserFollowerRepo, followingRepo repo.UserFollowingRepo) *RelationServiceServer {
+func NewRelationServiceServer(followerRepo repo.UserFollowerRepo, followingRepo repo.UserFollowingRepo,
+	suggestionRepo repo.FollowSuggestionRepo, groupRepo repo.RelationGroupRepo,
+	groupMemberRepo repo.RelationGroupMemberRepo, closeFriendRepo repo.UserCloseFriendRepo,
+	relationLogRepo repo.RelationLogRepo, sourceStatRepo repo.FollowSourceStatRepo,
+	growthRepo repo.GrowthLeaderboardRepo, snapshotRepo repo.UserRelationSnapshotRepo, blockRepo repo.UserBlockRepo,
+	invalidator *repo.CacheInvalidator, publisher event.Publisher, quotaChecker *quota.Checker,
+	userChecker usercheck.UserChecker) *RelationServiceServer {
 	return &RelationServiceServer{
-		followerRepo:  followerRepo,
-		followingRepo: followingRepo,
+		followerRepo:    followerRepo,
+		followingRepo:   followingRepo,
+		suggestionRepo:  suggestionRepo,
+		groupRepo:       groupRepo,
+		groupMemberRepo: groupMemberRepo,
+		closeFriendRepo: closeFriendRepo,
+		relationLogRepo: relationLogRepo,
+		sourceStatRepo:  sourceStatRepo,
+		growthRepo:      growthRepo,
+		snapshotRepo:    snapshotRepo,
+		blockRepo:       blockRepo,
+		invalidator:     invalidator,
+		publisher:       publisher,
+		quotaChecker:    quotaChecker,
+		userChecker:     userChecker,
 	}
 }
 
@@ -42,29 +80,58 @@ func NewRelationServiceServer(followerRepo repo.UserFollowerRepo, followingRepo
 func (s *RelationServiceServer) Follow(ctx context.Context, req *pb.FollowRequest) (*pb.FollowReply, error) {
 	// if is follow self
 	if isSelf(req.GetUserId(), req.GetFollowedUid()) {
-		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": errors.New("can not follow yourself"),
-		})).Status(req).Err()
+		return nil, ecode.ErrFollowSelf.WithDetails().Status(req).Err()
 	}
 
-	// check if has followed
-	following, err := s.followingRepo.GetUserFollowing(ctx, req.UserId, req.FollowedUid)
+	// check if has followed, read from DB since a stale cache may skip the follow
+	following, err := s.followingRepo.GetUserFollowingWithoutCache(ctx, req.UserId, req.FollowedUid)
 	if err != nil {
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 	// has follow
 	if following != nil && following.Status == FollowStatusNormal {
 		return &pb.FollowReply{}, nil
 	}
 
+	// the target must exist and not be banned or deactivated
+	if err := usercheck.CheckUser(ctx, s.userChecker, req.FollowedUid); err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// can not follow if either of them blocked the other
+	blocked, err := s.blockRepo.IsBlockedBetween(ctx, req.UserId, req.FollowedUid)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	if blocked {
+		return nil, ecode.ErrBlocked.WithDetails().Status(req).Err()
+	}
+
+	// the new edge counts against the limit of the user's tier
+	q, err := s.quotaChecker.CheckFollow(ctx, req.UserId)
+	if errors.Is(err, quota.ErrLimitExceeded) {
+		return nil, ecode.ErrFollowLimitExceeded.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"limit": q.Limit,
+			"tier":  q.Tier,
+		})).Status(req).Err()
+	}
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	sourceMeta, err := encodeSourceMeta(req.GetSourceMeta())
+	if err != nil {
+		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+			"msg": err.Error(),
+		})).Status(req).Err()
+	}
+
+	// the caches are deleted after commit
+	ctx, invalidation := s.invalidator.Begin(ctx)
 	db := model.GetDB()
 	tx := db.Begin()
 	if tx.Error != nil {
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": tx.Error.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, tx.Error).Status(req).Err()
 	}
 
 	curTime := time.Now()
@@ -73,14 +140,14 @@ func (s *RelationServiceServer) Follow(ctx context.Context, req *pb.FollowReques
 		UserID:      req.UserId,
 		FollowedUID: req.FollowedUid,
 		Status:      FollowStatusNormal,
+		Source:      int(req.GetSource()),
+		SourceMeta:  sourceMeta,
 		CreatedAt:   curTime,
 		UpdatedAt:   curTime,
 	})
 	if err != nil {
 		tx.Rollback()
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 	// 添加到粉丝表
 	_, err = s.followerRepo.CreateUserFollower(ctx, tx, &model.UserFollowerModel{
@@ -92,9 +159,21 @@ func (s *RelationServiceServer) Follow(ctx context.Context, req *pb.FollowReques
 	})
 	if err != nil {
 		tx.Rollback()
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// 记录变更日志
+	_, err = s.relationLogRepo.CreateRelationLog(ctx, tx, &model.RelationLogModel{
+		UserID:       req.UserId,
+		TargetUID:    req.FollowedUid,
+		Action:       model.RelationLogActionFollow,
+		Source:       relationLogSource(ctx),
+		FollowSource: int(req.GetSource()),
+		CreatedAt:    curTime,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 
 	// 增加关注数
@@ -104,10 +183,17 @@ func (s *RelationServiceServer) Follow(ctx context.Context, req *pb.FollowReques
 	err = tx.Commit().Error
 	if err != nil {
 		tx.Rollback()
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
+	invalidation.Commit(ctx)
+
+	// 更新增长排行, 失败不影响关注结果
+	if err := s.growthRepo.IncrFollowerGrowth(ctx, req.FollowedUid, 1); err != nil {
+		log.WithContext(ctx).Warnf("incr follower growth err: %+v", err)
+	}
+	ev := event.NewEvent(event.TypeFollow, req.UserId, req.FollowedUid)
+	ev.Source = int32(req.GetSource())
+	s.publishEvent(ctx, ev)
 
 	return &pb.FollowReply{}, nil
 }
@@ -116,46 +202,66 @@ func (s *RelationServiceServer) Follow(ctx context.Context, req *pb.FollowReques
 func (s *RelationServiceServer) Unfollow(ctx context.Context, req *pb.UnfollowRequest) (*pb.UnfollowReply, error) {
 	// cannot unfollow self
 	if isSelf(req.GetUserId(), req.GetFollowedUid()) {
-		return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": errors.New("cannot unfollow self"),
-		})).Status(req).Err()
+		return nil, ecode.ErrFollowSelf.WithDetails().Status(req).Err()
 	}
 
 	// 已取关
 	following, err := s.followingRepo.GetUserFollowingWithoutCache(ctx, req.UserId, req.FollowedUid)
 	if err != nil {
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 	if following != nil && following.Status == FollowStatusDelete {
 		return &pb.UnfollowReply{}, nil
 	}
 
 	// 如果是已关注，执行取关逻辑
+	// the caches are deleted after commit
+	ctx, invalidation := s.invalidator.Begin(ctx)
 	db := model.GetDB()
 	tx := db.Begin()
 	if tx.Error != nil {
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": tx.Error.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, tx.Error).Status(req).Err()
 	}
 	// 删除关注
 	err = s.followingRepo.UpdateUserFollowingStatus(ctx, tx, req.UserId, req.FollowedUid, FollowStatusDelete)
 	if err != nil {
 		tx.Rollback()
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 
 	// 删除粉丝
 	err = s.followerRepo.UpdateUserFollowerStatus(ctx, tx, req.FollowedUid, req.UserId, FollowStatusDelete)
 	if err != nil {
 		tx.Rollback()
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// 从所有分组中移除
+	err = s.groupMemberRepo.DeleteGroupMemberByRelation(ctx, tx, req.UserId, req.FollowedUid)
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// 密友需要关注对方, 取关后从对方的密友中移除
+	err = s.closeFriendRepo.UpdateUserCloseFriendStatus(ctx, tx, req.FollowedUid, req.UserId, CloseFriendStatusDelete)
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// 记录变更日志
+	_, err = s.relationLogRepo.CreateRelationLog(ctx, tx, &model.RelationLogModel{
+		UserID:       req.UserId,
+		TargetUID:    req.FollowedUid,
+		Action:       model.RelationLogActionUnfollow,
+		Source:       relationLogSource(ctx),
+		FollowSource: following.Source,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 
 	// 减少关注数
@@ -165,14 +271,25 @@ func (s *RelationServiceServer) Unfollow(ctx context.Context, req *pb.UnfollowRe
 	err = tx.Commit().Error
 	if err != nil {
 		tx.Rollback()
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	invalidation.Commit(ctx)
+
+	if err := s.growthRepo.IncrFollowerGrowth(ctx, req.FollowedUid, -1); err != nil {
+		log.WithContext(ctx).Warnf("decr follower growth err: %+v", err)
 	}
+	s.publishEvent(ctx, event.NewEvent(event.TypeUnfollow, req.UserId, req.FollowedUid))
 
 	return &pb.UnfollowReply{}, nil
 }
 
+// publishEvent publish the relation event for the side effects, the failure does not affect the result
+func (s *RelationServiceServer) publishEvent(ctx context.Context, ev *event.Event) {
+	if err := s.publisher.Publish(ctx, ev); err != nil {
+		log.WithContext(ctx).Warnf("publish event %s err: %+v, user_id: %d, target_uid: %d", ev.Type, err, ev.UserID, ev.TargetUID)
+	}
+}
+
 func isSelf(UId, otherUId int64) bool {
 	return UId == otherUId
 }
@@ -184,18 +301,19 @@ func (s *RelationServiceServer) BatchGetRelation(ctx context.Context, req *pb.Ba
 
 	ret, err := s.followingRepo.BatchGetUserFollowing(ctx, req.GetUserId(), req.GetIds())
 	if err != nil {
-		return nil, ecode.ErrInternalError.WithDetails(errcode.NewDetails(map[string]interface{}{
-			"msg": err.Error(),
-		})).Status(req).Err()
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 
 	retMap := make(map[int64]int64)
+	attrMap := make(map[int64]*pb.FollowAttributes)
 	for _, v := range ret {
 		retMap[v.FollowedUID] = int64(v.Status)
+		attrMap[v.FollowedUID] = convertFollowAttributes(v)
 	}
 
 	return &pb.BatchGetRelationReply{
-		Result: retMap,
+		Result:     retMap,
+		Attributes: attrMap,
 	}, nil
 }
 
@@ -205,7 +323,7 @@ func (s *RelationServiceServer) GetFollowingList(ctx context.Context, req *pb.Fo
 	}
 	userFollowList, err := s.followingRepo.GetFollowingUserList(ctx, req.UserId, req.LastId, int(req.Limit))
 	if err != nil {
-		return nil, err
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 
 	var data []*pb.FollowingListReplyUserFollow
@@ -213,6 +331,7 @@ func (s *RelationServiceServer) GetFollowingList(ctx context.Context, req *pb.Fo
 		item := pb.FollowingListReplyUserFollow{
 			Id:          v.ID,
 			FollowedUid: v.FollowedUID,
+			Attributes:  convertFollowAttributes(v),
 		}
 		data = append(data, &item)
 	}
@@ -228,7 +347,7 @@ func (s *RelationServiceServer) GetFollowerList(ctx context.Context, req *pb.Fol
 	}
 	userFollowList, err := s.followerRepo.GetFollowerUserList(ctx, req.UserId, req.LastId, int(req.Limit))
 	if err != nil {
-		return nil, err
+		return nil, errorStatus(ctx, err).Status(req).Err()
 	}
 
 	var data []*pb.FollowerListReplyFollower
@@ -244,3 +363,158 @@ func (s *RelationServiceServer) GetFollowerList(ctx context.Context, req *pb.Fol
 		Result: data,
 	}, nil
 }
+
+// GetCommonFollowers 共同关注, eg: 你关注的 A、B 也关注了 TA
+func (s *RelationServiceServer) GetCommonFollowers(ctx context.Context, req *pb.CommonFollowersRequest) (*pb.CommonFollowersReply, error) {
+	if req.GetViewerId() == 0 || req.GetTargetId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = DefaultCommonFollowersLimit
+	}
+	if limit > MaxCommonFollowersLimit {
+		limit = MaxCommonFollowersLimit
+	}
+
+	uids, err := s.followingRepo.GetCommonFollowers(ctx, req.GetViewerId(), req.GetTargetId(), limit)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.CommonFollowersReply{
+		Uids: uids,
+	}, nil
+}
+
+// CountCommonFollowers 共同关注数
+func (s *RelationServiceServer) CountCommonFollowers(ctx context.Context, req *pb.CountCommonFollowersRequest) (*pb.CountCommonFollowersReply, error) {
+	if req.GetViewerId() == 0 || req.GetTargetId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	count, err := s.followingRepo.CountCommonFollowers(ctx, req.GetViewerId(), req.GetTargetId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	return &pb.CountCommonFollowersReply{
+		Count: count,
+	}, nil
+}
+
+// SuggestFollows 可能认识的人
+func (s *RelationServiceServer) SuggestFollows(ctx context.Context, req *pb.SuggestFollowsRequest) (*pb.SuggestFollowsReply, error) {
+	if req.GetUserId() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = DefaultSuggestFollowsLimit
+	}
+	if limit > repo.FollowSuggestionSize {
+		limit = repo.FollowSuggestionSize
+	}
+
+	suggestions, err := s.suggestionRepo.GetFollowSuggestions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+
+	// 预计算的结果可能已过时, 过滤掉之后新关注的用户
+	uids := make([]int64, 0, len(suggestions))
+	for _, v := range suggestions {
+		uids = append(uids, v.UID)
+	}
+	followed := make(map[int64]struct{})
+	if len(uids) > 0 {
+		ret, err := s.followingRepo.BatchGetUserFollowing(ctx, req.GetUserId(), uids)
+		if err != nil {
+			return nil, errorStatus(ctx, err).Status(req).Err()
+		}
+		for _, v := range ret {
+			followed[v.FollowedUID] = struct{}{}
+		}
+	}
+
+	var data []*pb.SuggestFollowsReplySuggestion
+	for _, v := range suggestions {
+		if len(data) >= limit {
+			break
+		}
+		if _, ok := followed[v.UID]; ok {
+			continue
+		}
+		data = append(data, &pb.SuggestFollowsReplySuggestion{
+			Uid:         v.UID,
+			MutualCount: v.MutualCount,
+		})
+	}
+
+	return &pb.SuggestFollowsReply{
+		Result: data,
+	}, nil
+}
+
+// UpdateFollowAttributes 修改备注名、特别关注、屏蔽动态
+func (s *RelationServiceServer) UpdateFollowAttributes(ctx context.Context, req *pb.UpdateFollowAttributesRequest) (*pb.UpdateFollowAttributesReply, error) {
+	if req.GetUserId() == 0 || req.GetFollowedUid() == 0 {
+		return nil, ecode.ErrInvalidArgument.WithDetails().Status(req).Err()
+	}
+
+	attrs := make(map[string]interface{})
+	if req.Remark != nil {
+		remark := strings.TrimSpace(req.GetRemark())
+		if utf8.RuneCountInString(remark) > MaxRemarkLen {
+			return nil, ecode.ErrInvalidArgument.WithDetails(errcode.NewDetails(map[string]interface{}{
+				"msg": "remark is too long",
+			})).Status(req).Err()
+		}
+		attrs["remark"] = remark
+	}
+	if req.Special != nil {
+		attrs["is_special"] = req.GetSpecial()
+	}
+	if req.Muted != nil {
+		attrs["is_muted"] = req.GetMuted()
+	}
+
+	following, err := s.followingRepo.GetUserFollowingWithoutCache(ctx, req.GetUserId(), req.GetFollowedUid())
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	// 只能修改已关注的用户
+	if following == nil || following.Status != FollowStatusNormal {
+		return nil, ecode.ErrNotFollowed.WithDetails().Status(req).Err()
+	}
+
+	// the cache is deleted after the update and once again after a delay
+	ctx, invalidation := s.invalidator.Begin(ctx)
+	err = s.followingRepo.UpdateUserFollowingAttributes(ctx, req.GetUserId(), req.GetFollowedUid(), attrs)
+	if err != nil {
+		return nil, errorStatus(ctx, err).Status(req).Err()
+	}
+	invalidation.Commit(ctx)
+
+	if v, ok := attrs["remark"]; ok {
+		following.Remark = v.(string)
+	}
+	if req.Special != nil {
+		following.IsSpecial = req.GetSpecial()
+	}
+	if req.Muted != nil {
+		following.IsMuted = req.GetMuted()
+	}
+
+	return &pb.UpdateFollowAttributesReply{
+		Attributes: convertFollowAttributes(following),
+	}, nil
+}
+
+func convertFollowAttributes(following *model.UserFollowingModel) *pb.FollowAttributes {
+	return &pb.FollowAttributes{
+		Remark:  following.Remark,
+		Special: following.IsSpecial,
+		Muted:   following.IsMuted,
+	}
+}
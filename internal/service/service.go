@@ -5,7 +5,21 @@ import "github.com/google/wire"
 const (
 	// MaxID 最大id
 	MaxID = 0xffffffffffff
+	// DefaultCommonFollowersLimit 共同关注默认返回数
+	DefaultCommonFollowersLimit = 3
+	// MaxCommonFollowersLimit 共同关注最大返回数
+	MaxCommonFollowersLimit = 100
+	// DefaultSuggestFollowsLimit 推荐关注默认返回数
+	DefaultSuggestFollowsLimit = 10
+	// MaxRelationGroupNum 每个用户最多可创建的分组数
+	MaxRelationGroupNum = 20
+	// MaxRelationGroupNameLen 分组名最大长度
+	MaxRelationGroupNameLen = 32
+	// MaxGroupMembersBatch 单次添加/移除分组成员的最大数量
+	MaxGroupMembersBatch = 100
+	// MaxRemarkLen 备注名最大长度
+	MaxRemarkLen = 32
 )
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewRelationServiceServer)
+var ProviderSet = wire.NewSet(NewRelationServiceServer, NewRelationAdminServiceServer)
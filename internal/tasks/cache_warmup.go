@@ -0,0 +1,135 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/hibiken/asynq"
+	"golang.org/x/time/rate"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeCacheWarmup 预热用户的关系缓存, 用于 redis 故障切换或清空后
+	TypeCacheWarmup = "relation:cache_warmup"
+
+	// CacheWarmupModeActive 最近有关注变化的用户
+	CacheWarmupModeActive = "active"
+	// CacheWarmupModeTop 粉丝数最多的用户
+	CacheWarmupModeTop = "top"
+
+	// DefaultCacheWarmupRate 默认每秒预热的用户数
+	DefaultCacheWarmupRate  = 50
+	defaultCacheWarmupLimit = 10000
+	cacheWarmupActiveWindow = 24 * time.Hour
+	// cacheWarmupTopWindow 粉丝数排行使用的快照范围
+	cacheWarmupTopWindow = 30 * 24 * time.Hour
+	cacheWarmupBatchSize = 1000
+)
+
+type CacheWarmupPayload struct {
+	// Mode active 或 top, 指定 UserIDs 时忽略
+	Mode    string
+	Limit   int
+	UserIDs []int64
+	// Rate 每秒预热的用户数
+	Rate int
+}
+
+func NewCacheWarmupTask(p CacheWarmupPayload) (*asynq.Task, error) {
+	if p.Limit <= 0 {
+		p.Limit = defaultCacheWarmupLimit
+	}
+	if p.Rate <= 0 {
+		p.Rate = DefaultCacheWarmupRate
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeCacheWarmup, payload), nil
+}
+
+func HandleCacheWarmupTask(ctx context.Context, t *asynq.Task) error {
+	var p CacheWarmupPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+	if p.Rate <= 0 {
+		return fmt.Errorf("invalid rate %d: %w", p.Rate, asynq.SkipRetry)
+	}
+
+	db := model.GetDB()
+	cacheCfg := getCacheConfig()
+	setCache := cache.NewRelationSetCache(redis.RedisClient, cacheCfg)
+	breaker := cache.NewBreaker(redis.RedisClient, cacheCfg)
+	followingRepo := repository.NewUserFollowing(db, cache.NewUserFollowingCache(redis.RedisClient, cacheCfg), setCache, breaker, cacheCfg)
+	followerRepo := repository.NewUserFollower(db, cache.NewUserFollowerCache(redis.RedisClient, cacheCfg), setCache, breaker, cacheCfg)
+
+	userIDs := p.UserIDs
+	if len(userIDs) == 0 {
+		var err error
+		switch p.Mode {
+		case CacheWarmupModeActive:
+			userIDs, err = getCacheWarmupActiveUserIDs(ctx, p.Limit)
+		case CacheWarmupModeTop:
+			userIDs, err = repository.NewUserRelationSnapshot(db).GetTopFollowedUserIDs(ctx, time.Now().Add(-cacheWarmupTopWindow), p.Limit)
+		default:
+			return fmt.Errorf("invalid mode %s: %w", p.Mode, asynq.SkipRetry)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// limit the rate to avoid overloading the DB which is serving the requests missing cache
+	limiter := rate.NewLimiter(rate.Limit(p.Rate), 1)
+	var warmed, failed int
+	for _, userID := range userIDs {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		_, err1 := followingRepo.WarmUserFollowingCache(ctx, userID)
+		_, err2 := followerRepo.WarmUserFollowerCache(ctx, userID)
+		if err1 != nil || err2 != nil {
+			failed++
+			log.Printf("warm cache failed: user_id=%d following_err=%v follower_err=%v", userID, err1, err2)
+			continue
+		}
+		warmed++
+	}
+	log.Printf("warm cache: mode=%s users=%d warmed=%d failed=%d", p.Mode, len(userIDs), warmed, failed)
+	return nil
+}
+
+func getCacheWarmupActiveUserIDs(ctx context.Context, limit int) ([]int64, error) {
+	repo := newFollowSuggestionRepo()
+	since := time.Now().Add(-cacheWarmupActiveWindow)
+
+	var (
+		ret        []int64
+		lastUserID int64
+	)
+	for len(ret) < limit {
+		userIDs, err := repo.GetActiveUserIDs(ctx, since, lastUserID, cacheWarmupBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, userIDs...)
+		if len(userIDs) < cacheWarmupBatchSize {
+			break
+		}
+		lastUserID = userIDs[len(userIDs)-1]
+	}
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
@@ -0,0 +1,59 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeFollowSourceRollup 汇总每日按来源的关注/取关数
+	TypeFollowSourceRollup = "relation:follow_source_rollup"
+)
+
+type FollowSourceRollupPayload struct {
+	// Date 格式 2006-01-02, 为空时汇总前一天
+	Date string
+}
+
+func NewFollowSourceRollupTask(date string) (*asynq.Task, error) {
+	payload, err := json.Marshal(FollowSourceRollupPayload{Date: date})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeFollowSourceRollup, payload), nil
+}
+
+func HandleFollowSourceRollupTask(ctx context.Context, t *asynq.Task) error {
+	var p FollowSourceRollupPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	day := time.Now().AddDate(0, 0, -1)
+	if p.Date != "" {
+		var err error
+		day, err = time.ParseInLocation("2006-01-02", p.Date, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date %s: %w", p.Date, asynq.SkipRetry)
+		}
+	}
+
+	repo := repository.NewFollowSourceStat(model.GetDB())
+	stats, err := repo.AggregateFollowSourceStat(ctx, day)
+	if err != nil {
+		return err
+	}
+	if err := repo.BatchUpsertFollowSourceStat(ctx, stats); err != nil {
+		return err
+	}
+	log.Printf("rollup follow source stats: date=%s sources=%d", day.Format("2006-01-02"), len(stats))
+	return nil
+}
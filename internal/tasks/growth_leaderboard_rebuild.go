@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeGrowthLeaderboardRebuild 重建增长排行的桶, 用于冷启动或 redis 数据丢失
+	TypeGrowthLeaderboardRebuild = "relation:growth_leaderboard:rebuild"
+
+	// growthLeaderboardMaxWindow 最大的统计窗口
+	growthLeaderboardMaxWindow = 7 * 24 * time.Hour
+)
+
+type GrowthLeaderboardRebuildPayload struct {
+	// Force 为 true 时覆盖已存在的桶
+	Force bool
+}
+
+func NewGrowthLeaderboardRebuildTask(force bool) (*asynq.Task, error) {
+	payload, err := json.Marshal(GrowthLeaderboardRebuildPayload{Force: force})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeGrowthLeaderboardRebuild, payload), nil
+}
+
+func HandleGrowthLeaderboardRebuildTask(ctx context.Context, t *asynq.Task) error {
+	var p GrowthLeaderboardRebuildPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	repo := repository.NewGrowthLeaderboard(model.GetDB(), cache.NewGrowthLeaderboardCache(redis.RedisClient, getCacheConfig()))
+	rebuilt, err := repo.RebuildGrowthLeaderboard(ctx, growthLeaderboardMaxWindow, p.Force)
+	if err != nil {
+		return err
+	}
+	if rebuilt > 0 {
+		log.Printf("rebuild growth leaderboard: buckets=%d force=%v", rebuilt, p.Force)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/exporter"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeRelationExport 导出关注关系到文件, 用于离线分析
+	TypeRelationExport = "relation:export"
+
+	// DefaultRelationExportTimeout 默认的导出超时时间
+	DefaultRelationExportTimeout = 6 * time.Hour
+)
+
+// ExportConfig relation export config
+type ExportConfig struct {
+	// Dir 导出目录, 为空时不执行导出
+	Dir string
+	// Format jsonl 或 parquet
+	Format        string
+	PartitionRows int
+	// FullSpec 全量导出的 cron 表达式, 为空时不执行
+	FullSpec string
+	// IncrementalSpec 增量导出的 cron 表达式, 为空时不执行
+	IncrementalSpec string
+	Timeout         time.Duration
+}
+
+type RelationExportPayload struct {
+	// Mode full 或 incremental
+	Mode          string
+	Dir           string
+	Format        string
+	PartitionRows int
+}
+
+func NewRelationExportTask(mode string, cfg ExportConfig) (*asynq.Task, error) {
+	payload, err := json.Marshal(RelationExportPayload{
+		Mode:          mode,
+		Dir:           cfg.Dir,
+		Format:        cfg.Format,
+		PartitionRows: cfg.PartitionRows,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeRelationExport, payload), nil
+}
+
+// HandleRelationExportTask the files are written to the local dir of the worker
+func HandleRelationExportTask(ctx context.Context, t *asynq.Task) error {
+	var p RelationExportPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+	if p.Dir == "" {
+		return fmt.Errorf("export dir is empty: %w", asynq.SkipRetry)
+	}
+	if p.Mode != exporter.ModeFull && p.Mode != exporter.ModeIncremental {
+		return fmt.Errorf("invalid export mode %s: %w", p.Mode, asynq.SkipRetry)
+	}
+
+	// the table is scanned from the replica, the primary is read by batches without a long transaction
+	db, isReplica, err := model.GetReplicaDB()
+	if err != nil {
+		return err
+	}
+	if !isReplica {
+		log.Printf("export relation: no %s is configured in database.yaml, read from the primary", model.ReplicaName)
+	}
+	cacheCfg := getCacheConfig()
+	repo := repository.NewUserFollowing(db, cache.NewUserFollowingCache(redis.RedisClient, cacheCfg),
+		cache.NewRelationSetCache(redis.RedisClient, cacheCfg), cache.NewBreaker(redis.RedisClient, cacheCfg), cacheCfg)
+	manifest, err := exporter.New(db, repo, exporter.Options{
+		Dir:           p.Dir,
+		Format:        p.Format,
+		PartitionRows: p.PartitionRows,
+		Mode:          p.Mode,
+		Snapshot:      isReplica,
+	}).Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("export relation: mode=%s rows=%d partitions=%d max_id=%d",
+		manifest.Mode, manifest.Rows, len(manifest.Partitions), manifest.MaxID)
+	return nil
+}
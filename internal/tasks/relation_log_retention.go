@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeRelationLogRetention 清理过期的关系变更日志
+	TypeRelationLogRetention = "relation:log_retention"
+
+	// DefaultRelationLogRetentionDays 默认保留天数
+	DefaultRelationLogRetentionDays = 180
+	relationLogDeleteBatchSize      = 1000
+)
+
+type RelationLogRetentionPayload struct {
+	RetentionDays int
+}
+
+func NewRelationLogRetentionTask(retentionDays int) (*asynq.Task, error) {
+	if retentionDays <= 0 {
+		retentionDays = DefaultRelationLogRetentionDays
+	}
+	payload, err := json.Marshal(RelationLogRetentionPayload{RetentionDays: retentionDays})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeRelationLogRetention, payload), nil
+}
+
+func HandleRelationLogRetentionTask(ctx context.Context, t *asynq.Task) error {
+	var p RelationLogRetentionPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+	if p.RetentionDays <= 0 {
+		return fmt.Errorf("invalid retention days %d: %w", p.RetentionDays, asynq.SkipRetry)
+	}
+
+	repo := repository.NewRelationLog(model.GetDB())
+	before := time.Now().AddDate(0, 0, -p.RetentionDays)
+	var total int64
+	// delete in small batches to avoid holding locks for a long time
+	for {
+		n, err := repo.DeleteRelationLogBefore(ctx, before, relationLogDeleteBatchSize)
+		if err != nil {
+			return err
+		}
+		total += n
+		if n < relationLogDeleteBatchSize {
+			break
+		}
+	}
+	log.Printf("delete expired relation logs: before=%s count=%d", before.Format(time.RFC3339), total)
+	return nil
+}
@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeRelationSnapshot 记录用户每日的粉丝数/关注数快照
+	TypeRelationSnapshot = "relation:snapshot"
+	// TypeRelationSnapshotRetention 清理过期的关系数快照
+	TypeRelationSnapshotRetention = "relation:snapshot_retention"
+
+	// DefaultRelationSnapshotRetentionDays 默认保留天数
+	DefaultRelationSnapshotRetentionDays = 730
+	relationSnapshotBatchSize            = 500
+)
+
+type RelationSnapshotPayload struct {
+	// Date 格式 2006-01-02, 为空时记录前一天
+	Date string
+}
+
+type RelationSnapshotRetentionPayload struct {
+	RetentionDays int
+}
+
+func NewRelationSnapshotTask(date string) (*asynq.Task, error) {
+	payload, err := json.Marshal(RelationSnapshotPayload{Date: date})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeRelationSnapshot, payload), nil
+}
+
+func NewRelationSnapshotRetentionTask(retentionDays int) (*asynq.Task, error) {
+	if retentionDays <= 0 {
+		retentionDays = DefaultRelationSnapshotRetentionDays
+	}
+	payload, err := json.Marshal(RelationSnapshotRetentionPayload{RetentionDays: retentionDays})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeRelationSnapshotRetention, payload), nil
+}
+
+// HandleRelationSnapshotTask only the users whose relations changed in the day are recorded,
+// the others keep the value of their latest snapshot.
+// a past day can be backfilled while its relation logs are retained, the counts are rewound by the later logs.
+func HandleRelationSnapshotTask(ctx context.Context, t *asynq.Task) error {
+	var p RelationSnapshotPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	day := time.Now().AddDate(0, 0, -1)
+	if p.Date != "" {
+		var err error
+		day, err = time.ParseInLocation("2006-01-02", p.Date, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date %s: %w", p.Date, asynq.SkipRetry)
+		}
+	}
+
+	repo := repository.NewUserRelationSnapshot(model.GetDB())
+	var (
+		lastUserID int64
+		total      int
+	)
+	for {
+		userIDs, err := repo.GetSnapshotActiveUserIDs(ctx, day, lastUserID, relationSnapshotBatchSize)
+		if err != nil {
+			return err
+		}
+		snapshots, err := repo.BuildUserRelationSnapshot(ctx, day, userIDs)
+		if err != nil {
+			return err
+		}
+		if err := repo.BatchUpsertUserRelationSnapshot(ctx, snapshots); err != nil {
+			return err
+		}
+		total += len(snapshots)
+		if len(userIDs) < relationSnapshotBatchSize {
+			break
+		}
+		lastUserID = userIDs[len(userIDs)-1]
+	}
+	log.Printf("record relation snapshots: date=%s users=%d", day.Format("2006-01-02"), total)
+	return nil
+}
+
+func HandleRelationSnapshotRetentionTask(ctx context.Context, t *asynq.Task) error {
+	var p RelationSnapshotRetentionPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+	if p.RetentionDays <= 0 {
+		return fmt.Errorf("invalid retention days %d: %w", p.RetentionDays, asynq.SkipRetry)
+	}
+
+	repo := repository.NewUserRelationSnapshot(model.GetDB())
+	before := time.Now().AddDate(0, 0, -p.RetentionDays)
+	var total int64
+	for {
+		n, err := repo.DeleteUserRelationSnapshotBefore(ctx, before, relationLogDeleteBatchSize)
+		if err != nil {
+			return err
+		}
+		total += n
+		if n < relationLogDeleteBatchSize {
+			break
+		}
+	}
+	log.Printf("delete expired relation snapshots: before=%s count=%d", before.Format("2006-01-02"), total)
+	return nil
+}
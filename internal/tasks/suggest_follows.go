@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/repository"
+)
+
+const (
+	// TypeSuggestFollowsDispatch 扫描活跃用户并分发推荐计算任务
+	TypeSuggestFollowsDispatch = "relation:suggest_follows:dispatch"
+	// TypeSuggestFollows 计算单个用户的推荐关注
+	TypeSuggestFollows = "relation:suggest_follows"
+
+	// suggestFollowsActiveWindow 只为该时间窗口内有关注变化的用户预计算
+	suggestFollowsActiveWindow = 7 * 24 * time.Hour
+	suggestFollowsBatchSize    = 1000
+)
+
+type SuggestFollowsPayload struct {
+	UserID int64
+}
+
+func NewSuggestFollowsDispatchTask() (*asynq.Task, error) {
+	return asynq.NewTask(TypeSuggestFollowsDispatch, nil), nil
+}
+
+func NewSuggestFollowsTask(userID int64) (*asynq.Task, error) {
+	payload, err := json.Marshal(SuggestFollowsPayload{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeSuggestFollows, payload), nil
+}
+
+func newFollowSuggestionRepo() repository.FollowSuggestionRepo {
+	cacheCfg := getCacheConfig()
+	return repository.NewFollowSuggestion(model.GetDB(), cache.NewFollowSuggestionCache(redis.RedisClient, cacheCfg), cacheCfg)
+}
+
+// NewSuggestFollowsDispatchHandler the handler enqueues the tasks of the active users by the client
+func NewSuggestFollowsDispatchHandler(client *asynq.Client) asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		repo := newFollowSuggestionRepo()
+		since := time.Now().Add(-suggestFollowsActiveWindow)
+
+		var lastUserID int64
+		for {
+			userIDs, err := repo.GetActiveUserIDs(ctx, since, lastUserID, suggestFollowsBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, userID := range userIDs {
+				task, err := NewSuggestFollowsTask(userID)
+				if err != nil {
+					return err
+				}
+				// unique avoids piling up the same user when the previous round is not finished
+				_, err = client.EnqueueContext(ctx, task, asynq.Queue(QueueLow), asynq.Unique(time.Hour))
+				if err != nil && !errors.Is(err, asynq.ErrDuplicateTask) {
+					return fmt.Errorf("enqueue suggest follows task err: %v", err)
+				}
+			}
+			if len(userIDs) < suggestFollowsBatchSize {
+				break
+			}
+			lastUserID = userIDs[len(userIDs)-1]
+		}
+		return nil
+	}
+}
+
+func HandleSuggestFollowsTask(ctx context.Context, t *asynq.Task) error {
+	var p SuggestFollowsPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+	ret, err := newFollowSuggestionRepo().RefreshFollowSuggestions(ctx, p.UserID)
+	if err != nil {
+		return err
+	}
+	log.Printf("refresh follow suggestions: user_id=%d count=%d", p.UserID, len(ret))
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/go-eagle/eagle/pkg/config"
 
 	"github.com/hibiken/asynq"
+
+	"github.com/go-microservice/relation-service/internal/cache"
 )
 
 const (
@@ -18,8 +20,8 @@ const (
 )
 
 var (
-	client *asynq.Client
-	once   sync.Once
+	cacheCfg     *cache.Config
+	cacheCfgOnce sync.Once
 )
 
 type Config struct {
@@ -33,29 +35,56 @@ type Config struct {
 	PoolSize     int
 	PoolTimeout  time.Duration
 	Concurrency  int //并发数
+	// RelationLogRetentionDays 关系变更日志保留天数
+	RelationLogRetentionDays int
+	// RelationSnapshotRetentionDays 关系数快照保留天数
+	RelationSnapshotRetentionDays int
+	// CacheWarmupRate 预热缓存时每秒处理的用户数
+	CacheWarmupRate int
+	// Export 关注关系导出
+	Export ExportConfig
 }
 
-func GetClient() *asynq.Client {
-	once.Do(func() {
-		c := config.New("config", config.WithEnv("local"))
-		var cfg Config
-		if err := c.Load("cron", &cfg); err != nil {
-			panic(err)
-		}
-		client = asynq.NewClient(asynq.RedisClientOpt{
-			Addr:         cfg.Addr,
-			Password:     cfg.Password,
-			DB:           cfg.DB,
-			DialTimeout:  cfg.DialTimeout,
-			ReadTimeout:  cfg.ReadTimeout,
-			WriteTimeout: cfg.WriteTimeout,
-			PoolSize:     cfg.PoolSize,
-		})
+// NewClient new a client on the redis of cron.yaml, it is used by the server and the cron to enqueue tasks
+func NewClient() (*asynq.Client, func(), error) {
+	v, err := config.LoadWithType("cron", "yaml")
+	if err != nil {
+		return nil, nil, err
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, nil, err
+	}
+	c := asynq.NewClient(asynq.RedisClientOpt{
+		Addr:         cfg.Addr,
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		DialTimeout:  cfg.DialTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		PoolSize:     cfg.PoolSize,
 	})
-	return client
+	cleanup := func() {
+		c.Close()
+	}
+	return c, cleanup, nil
+}
+
+// getCacheConfig the cache config shared with the server, so that the keys are the same
+func getCacheConfig() *cache.Config {
+	cacheCfgOnce.Do(func() {
+		cacheCfg = cache.MustLoadConf()
+	})
+	return cacheCfg
 }
 
 func Example() {
+	client, cleanup, err := NewClient()
+	if err != nil {
+		log.Fatalf("could not create client: %v", err)
+	}
+	defer cleanup()
+
 	// ------------------------------------------------------
 	// Enqueue task to be processed immediately.
 	// Use (*Client).Enqueue method.
@@ -64,7 +93,7 @@ func Example() {
 	if err != nil {
 		log.Fatalf("could not create task: %v", err)
 	}
-	info, err := GetClient().Enqueue(task, asynq.Queue(QueueDefault))
+	info, err := client.Enqueue(task, asynq.Queue(QueueDefault))
 	if err != nil {
 		log.Fatalf("could not enqueue task: %v", err)
 	}
@@ -78,7 +107,7 @@ func Example() {
 	if err != nil {
 		log.Fatalf("could not create task: %v", err)
 	}
-	info, err = GetClient().Enqueue(task, asynq.ProcessIn(10*time.Second))
+	info, err = client.Enqueue(task, asynq.ProcessIn(10*time.Second))
 	if err != nil {
 		log.Fatalf("could not enqueue task: %v", err)
 	}
@@ -92,7 +121,7 @@ func Example() {
 	if err != nil {
 		log.Fatalf("could not create task: %v", err)
 	}
-	info, err = GetClient().Enqueue(task, asynq.MaxRetry(10), asynq.Timeout(3*time.Minute))
+	info, err = client.Enqueue(task, asynq.MaxRetry(10), asynq.Timeout(3*time.Minute))
 	if err != nil {
 		log.Fatalf("could not enqueue task: %v", err)
 	}
@@ -0,0 +1,164 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/redis"
+	"github.com/hibiken/asynq"
+	"gorm.io/gorm"
+
+	"github.com/go-microservice/relation-service/internal/cache"
+	"github.com/go-microservice/relation-service/internal/event"
+	"github.com/go-microservice/relation-service/internal/lifecycle"
+	"github.com/go-microservice/relation-service/internal/model"
+	"github.com/go-microservice/relation-service/internal/quota"
+	"github.com/go-microservice/relation-service/internal/repository"
+	"github.com/go-microservice/relation-service/internal/usercheck"
+)
+
+const (
+	// TypeUserRelationMerge 合并账号时把被合并用户的关注和粉丝转移给保留的用户
+	TypeUserRelationMerge = "relation:user_merge"
+
+	// DefaultUserRelationMergeTimeout 每次执行的超时时间, 超时后重试时从保存的游标继续
+	DefaultUserRelationMergeTimeout = time.Hour
+	// DefaultUserRelationMergeMaxRetry 重试次数, 用完后合并记录标记为失败
+	DefaultUserRelationMergeMaxRetry = 10
+)
+
+type UserRelationMergePayload struct {
+	// MergeID user_relation_merge 表的主键
+	MergeID int64
+}
+
+func NewUserRelationMergeTask(mergeID int64) (*asynq.Task, error) {
+	payload, err := json.Marshal(UserRelationMergePayload{MergeID: mergeID})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeUserRelationMerge, payload), nil
+}
+
+// UserRelationMergeOpts the task id is bound to the merge, so that a merge is enqueued once
+func UserRelationMergeOpts(mergeID int64) []asynq.Option {
+	return []asynq.Option{
+		asynq.Queue(QueueLow),
+		asynq.TaskID(fmt.Sprintf("%s:%d", TypeUserRelationMerge, mergeID)),
+		asynq.Timeout(DefaultUserRelationMergeTimeout),
+		asynq.MaxRetry(DefaultUserRelationMergeMaxRetry),
+	}
+}
+
+// HandleUserRelationMergeTask transfer the edges batch by batch from the cursor of the merge record,
+// the cursor is saved after each batch. A batch is replayed safely if the cursor fails to save,
+// because only the active edges of from_uid are scanned.
+func HandleUserRelationMergeTask(ctx context.Context, t *asynq.Task) error {
+	var p UserRelationMergePayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	db := model.GetDB()
+	mergeRepo := repository.NewUserRelationMerge(db)
+	merge, err := mergeRepo.GetUserRelationMerge(ctx, p.MergeID)
+	if err != nil {
+		return err
+	}
+	if merge == nil {
+		return fmt.Errorf("merge %d not found: %w", p.MergeID, asynq.SkipRetry)
+	}
+	if merge.Status == model.UserRelationMergeStatusDone {
+		return nil
+	}
+
+	if err := mergeUserRelations(ctx, db, mergeRepo, merge); err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, _ := asynq.GetMaxRetry(ctx)
+		// the edges are not transferred to an unavailable user, the merge fails without retries
+		if errors.Is(err, lifecycle.ErrTargetUnavailable) {
+			err = fmt.Errorf("to_uid %d: %w: %w", merge.ToUID, err, asynq.SkipRetry)
+			retried = maxRetry
+		}
+		if retried >= maxRetry {
+			// ctx may be done by the timeout
+			if err := mergeRepo.FinishUserRelationMerge(context.WithoutCancel(ctx), merge.ID, model.UserRelationMergeStatusFailed, err.Error()); err != nil {
+				log.Printf("mark merge failed err: merge_id=%d err=%v", merge.ID, err)
+			}
+		}
+		return err
+	}
+
+	if err := mergeRepo.FinishUserRelationMerge(ctx, merge.ID, model.UserRelationMergeStatusDone, ""); err != nil {
+		return err
+	}
+	log.Printf("merge user relations: merge_id=%d from_uid=%d to_uid=%d removed=%d transferred=%d duplicates=%d over_limit=%d unavailable=%d",
+		merge.ID, merge.FromUID, merge.ToUID, merge.Removed, merge.Transferred, merge.Duplicates, merge.OverLimit,
+		merge.Unavailable)
+	return nil
+}
+
+func mergeUserRelations(ctx context.Context, db *gorm.DB, mergeRepo repository.UserRelationMergeRepo,
+	merge *model.UserRelationMergeModel) error {
+	quotaCfg, err := quota.LoadConf()
+	if err != nil {
+		return err
+	}
+	cacheCfg := getCacheConfig()
+	setCache := cache.NewRelationSetCache(redis.RedisClient, cacheCfg)
+	breaker := cache.NewBreaker(redis.RedisClient, cacheCfg)
+	followingRepo := repository.NewUserFollowing(db, cache.NewUserFollowingCache(redis.RedisClient, cacheCfg), setCache, breaker, cacheCfg)
+	followerRepo := repository.NewUserFollower(db, cache.NewUserFollowerCache(redis.RedisClient, cacheCfg), setCache, breaker, cacheCfg)
+	eventCfg, err := event.LoadConf()
+	if err != nil {
+		return err
+	}
+	// the other users of the transferred edges must exist, and the tier of to_uid limits its followings
+	userChecker, closeUserChecker, err := usercheck.NewUserChecker(usercheck.LoadConf())
+	if err != nil {
+		return err
+	}
+	defer closeUserChecker()
+	quotaChecker := quota.NewChecker(quotaCfg, quota.NewUserTierProvider(userChecker), followingRepo)
+	publisher, closePublisher, err := event.NewPublisher(eventCfg)
+	if err != nil {
+		return err
+	}
+	defer closePublisher()
+	invalidator, waitInvalidation := repository.NewCacheInvalidator(cacheCfg)
+	defer waitInvalidation()
+	graph := lifecycle.NewGraph(db, followingRepo, followerRepo,
+		repository.NewUserCloseFriend(db, cache.NewUserCloseFriendCache(redis.RedisClient, cacheCfg), cacheCfg),
+		repository.NewRelationGroupMember(db), repository.NewRelationLog(db),
+		invalidator, publisher, quotaChecker, userChecker, lifecycle.DefaultEdgeBatchSize)
+
+	if err := graph.CheckTarget(ctx, merge.ToUID); err != nil {
+		return err
+	}
+
+	cursor := lifecycle.Cursor{Phase: merge.Phase, LastID: merge.LastID}
+	stats := &lifecycle.Stats{Removed: merge.Removed, Transferred: merge.Transferred, Duplicates: merge.Duplicates,
+		OverLimit: merge.OverLimit, Unavailable: merge.Unavailable}
+	for !cursor.Done() {
+		next, err := graph.Step(ctx, merge.FromUID, merge.ToUID, cursor, stats)
+		if err != nil {
+			return err
+		}
+		cursor = next
+		merge.Phase, merge.LastID = cursor.Phase, cursor.LastID
+		merge.Removed, merge.Transferred, merge.Duplicates = stats.Removed, stats.Transferred, stats.Duplicates
+		merge.OverLimit, merge.Unavailable = stats.OverLimit, stats.Unavailable
+		if err := mergeRepo.UpdateUserRelationMergeProgress(ctx, merge); err != nil {
+			return err
+		}
+	}
+
+	// the counts of both users are changed at once, the others are counted by the consumer of the events
+	_, err = repository.NewUserRelationSnapshot(db).RefreshUserRelationSnapshot(ctx, time.Now(),
+		[]int64{merge.FromUID, merge.ToUID})
+	return err
+}
@@ -0,0 +1,98 @@
+package usercheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/go-eagle/eagle/pkg/log"
+)
+
+// cachedChecker cache the status and the tier in process, the banned and deactivated users are cached shorter
+type cachedChecker struct {
+	checker UserChecker
+	store   *ristretto.Cache
+	cfg     *Config
+}
+
+func newCachedChecker(checker UserChecker, cfg *Config) UserChecker {
+	// every item costs 1, so MaxCost is the max number of users
+	store, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: cfg.CacheSize * 10,
+		MaxCost:     cfg.CacheSize,
+		BufferItems: 64,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &cachedChecker{
+		checker: checker,
+		store:   store,
+		cfg:     cfg,
+	}
+}
+
+// GetUserStatus get the missed users from the checker
+func (c *cachedChecker) GetUserStatus(ctx context.Context, userIDs []int64) (map[int64]Status, error) {
+	statuses := make(map[int64]Status, len(userIDs))
+	missed := make([]int64, 0, len(userIDs))
+	for _, uid := range userIDs {
+		if _, ok := statuses[uid]; ok {
+			continue
+		}
+		if v, ok := c.store.Get(uid); ok {
+			statuses[uid] = v.(Status)
+			continue
+		}
+		// a placeholder to skip the duplicated ids, it is overwritten below
+		statuses[uid] = StatusNormal
+		missed = append(missed, uid)
+	}
+	if len(missed) == 0 {
+		return statuses, nil
+	}
+
+	fetched, err := c.checker.GetUserStatus(ctx, missed)
+	if err != nil {
+		if !c.cfg.FailOpen {
+			return nil, err
+		}
+		// the missed users are treated as normal
+		log.WithContext(ctx).Warnf("[usercheck] get user status err: %v, the users are allowed", err)
+		return statuses, nil
+	}
+	for _, uid := range missed {
+		status := fetched[uid]
+		statuses[uid] = status
+		ttl := c.cfg.CacheTTL
+		if status != StatusNormal {
+			ttl = c.cfg.NegativeCacheTTL
+		}
+		c.store.SetWithTTL(uid, status, 1, ttl)
+	}
+	return statuses, nil
+}
+
+// GetUserTier get the tier from the checker if it is not cached, the tier is cached as long as a normal user
+func (c *cachedChecker) GetUserTier(ctx context.Context, userID int64) (string, error) {
+	key := tierKey(userID)
+	if v, ok := c.store.Get(key); ok {
+		return v.(string), nil
+	}
+	tier, err := c.checker.GetUserTier(ctx, userID)
+	if err != nil {
+		if !c.cfg.FailOpen {
+			return "", err
+		}
+		// the default tier is used
+		log.WithContext(ctx).Warnf("[usercheck] get user tier err: %v, the default tier is used", err)
+		return "", nil
+	}
+	c.store.SetWithTTL(key, tier, 1, c.cfg.CacheTTL)
+	return tier, nil
+}
+
+// tierKey the tiers are cached with the statuses, whose keys are the user ids
+func tierKey(userID int64) string {
+	return fmt.Sprintf("tier:%d", userID)
+}
@@ -0,0 +1,85 @@
+package usercheck
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet is user check providers.
+var ProviderSet = wire.NewSet(LoadConf, NewUserChecker)
+
+var (
+	// ErrUserNotFound the user does not exist
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserUnavailable the user is banned or deactivated
+	ErrUserUnavailable = errors.New("user is banned or deactivated")
+)
+
+// Status the status of a user in the user service
+type Status int
+
+// the status of the users
+const (
+	StatusNormal Status = iota
+	StatusNotFound
+	StatusBanned
+	StatusDeactivated
+)
+
+// Err the error of the status, nil if the user can be followed
+func (s Status) Err() error {
+	switch s {
+	case StatusNormal:
+		return nil
+	case StatusNotFound:
+		return ErrUserNotFound
+	default:
+		return ErrUserUnavailable
+	}
+}
+
+// UserChecker check whether the users exist and can be followed
+type UserChecker interface {
+	// GetUserStatus batch get the status of the users, every user id is in the result
+	GetUserStatus(ctx context.Context, userIDs []int64) (map[int64]Status, error)
+	// GetUserTier get the tier of the user, eg: regular, verified, business, it is empty if the user is not found
+	GetUserTier(ctx context.Context, userID int64) (string, error)
+}
+
+// CheckUser return ErrUserNotFound or ErrUserUnavailable if the user can not be followed
+func CheckUser(ctx context.Context, checker UserChecker, userID int64) error {
+	statuses, err := checker.GetUserStatus(ctx, []int64{userID})
+	if err != nil {
+		return err
+	}
+	return statuses[userID].Err()
+}
+
+// NewUserChecker new a cached checker calling the user service, all the users are normal
+// and their tiers are unknown if it is disabled
+func NewUserChecker(cfg *Config) (UserChecker, func(), error) {
+	if !cfg.Enable {
+		return nopChecker{}, func() {}, nil
+	}
+	checker, cleanup, err := NewGRPCChecker(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return newCachedChecker(checker, cfg), cleanup, nil
+}
+
+type nopChecker struct{}
+
+func (nopChecker) GetUserStatus(ctx context.Context, userIDs []int64) (map[int64]Status, error) {
+	statuses := make(map[int64]Status, len(userIDs))
+	for _, uid := range userIDs {
+		statuses[uid] = StatusNormal
+	}
+	return statuses, nil
+}
+
+func (nopChecker) GetUserTier(ctx context.Context, userID int64) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,65 @@
+package usercheck
+
+import (
+	"time"
+
+	"github.com/go-eagle/eagle/pkg/config"
+	"github.com/go-eagle/eagle/pkg/log"
+)
+
+const (
+	defaultTimeout          = time.Second
+	defaultCacheTTL         = 5 * time.Minute
+	defaultNegativeCacheTTL = time.Minute
+	defaultCacheSize        = 100000
+)
+
+// Config user check config, see config/{env}/user.yaml
+type Config struct {
+	// Enable 是否检查目标用户, 关闭时所有用户视为正常
+	Enable bool
+	// Endpoint 用户服务的 grpc 地址
+	Endpoint string
+	// Timeout 调用用户服务的超时时间
+	Timeout time.Duration
+	// CacheTTL 正常用户的缓存时间
+	CacheTTL time.Duration
+	// NegativeCacheTTL 不存在、封禁、注销用户的缓存时间, 新注册或解封的用户在过期后才能被关注
+	NegativeCacheTTL time.Duration
+	// CacheSize 最多缓存的用户数
+	CacheSize int64
+	// FailOpen 用户服务不可用时是否放行, 放行的结果不缓存
+	FailOpen bool
+}
+
+// LoadConf load user check config, the check is disabled if user.yaml is absent
+func LoadConf() *Config {
+	v, err := config.LoadWithType("user", "yaml")
+	if err != nil {
+		log.Warnf("load user config err: %v, the user check is disabled", err)
+		return &Config{}
+	}
+
+	var c Config
+	if err := v.Unmarshal(&c); err != nil {
+		log.Warnf("unmarshal user config err: %v, the user check is disabled", err)
+		return &Config{}
+	}
+	c.setDefaults()
+	return &c
+}
+
+func (c *Config) setDefaults() {
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.CacheTTL <= 0 {
+		c.CacheTTL = defaultCacheTTL
+	}
+	if c.NegativeCacheTTL <= 0 {
+		c.NegativeCacheTTL = defaultNegativeCacheTTL
+	}
+	if c.CacheSize <= 0 {
+		c.CacheSize = defaultCacheSize
+	}
+}
@@ -0,0 +1,74 @@
+package usercheck
+
+import (
+	"context"
+	"sync"
+)
+
+var _ UserChecker = (*FakeChecker)(nil)
+
+// FakeChecker an in-memory checker for tests, the users not set are not found
+type FakeChecker struct {
+	mu       sync.RWMutex
+	statuses map[int64]Status
+	tiers    map[int64]string
+	err      error
+}
+
+// NewFakeChecker new a fake checker with the normal users
+func NewFakeChecker(userIDs ...int64) *FakeChecker {
+	f := &FakeChecker{statuses: make(map[int64]Status, len(userIDs)), tiers: make(map[int64]string)}
+	for _, uid := range userIDs {
+		f.statuses[uid] = StatusNormal
+	}
+	return f
+}
+
+// SetStatus set the status of a user
+func (f *FakeChecker) SetStatus(userID int64, status Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statuses[userID] = status
+}
+
+// SetTier set the tier of a user
+func (f *FakeChecker) SetTier(userID int64, tier string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tiers[userID] = tier
+}
+
+// SetError make GetUserStatus fail with err, nil to recover
+func (f *FakeChecker) SetError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// GetUserStatus get the status set before
+func (f *FakeChecker) GetUserStatus(ctx context.Context, userIDs []int64) (map[int64]Status, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	statuses := make(map[int64]Status, len(userIDs))
+	for _, uid := range userIDs {
+		status, ok := f.statuses[uid]
+		if !ok {
+			status = StatusNotFound
+		}
+		statuses[uid] = status
+	}
+	return statuses, nil
+}
+
+// GetUserTier get the tier set before
+func (f *FakeChecker) GetUserTier(ctx context.Context, userID int64) (string, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.tiers[userID], nil
+}
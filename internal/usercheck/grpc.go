@@ -0,0 +1,84 @@
+package usercheck
+
+import (
+	"context"
+
+	"github.com/go-eagle/eagle/pkg/transport/grpc"
+	"github.com/pkg/errors"
+
+	"github.com/go-microservice/relation-service/internal/usercheck/userpb"
+)
+
+var _ UserChecker = (*GRPCChecker)(nil)
+
+// GRPCChecker get the users from the user service
+type GRPCChecker struct {
+	client userpb.UserServiceClient
+	cfg    *Config
+}
+
+// NewGRPCChecker dial the user service, the connection is closed by the cleanup
+func NewGRPCChecker(cfg *Config) (*GRPCChecker, func(), error) {
+	conn, err := grpc.DialInsecure(context.Background(), grpc.WithEndpoint(cfg.Endpoint))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "dial user service %s err", cfg.Endpoint)
+	}
+	checker := &GRPCChecker{
+		client: userpb.NewUserServiceClient(conn),
+		cfg:    cfg,
+	}
+	return checker, func() { _ = conn.Close() }, nil
+}
+
+// GetUserStatus the users not returned by the user service are not found
+func (c *GRPCChecker) GetUserStatus(ctx context.Context, userIDs []int64) (map[int64]Status, error) {
+	statuses := make(map[int64]Status, len(userIDs))
+	if len(userIDs) == 0 {
+		return statuses, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+	reply, err := c.client.BatchGetUsers(ctx, &userpb.BatchGetUsersRequest{Ids: userIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "[usercheck] batch get users err")
+	}
+
+	for _, uid := range userIDs {
+		statuses[uid] = StatusNotFound
+	}
+	for _, v := range reply.GetUsers() {
+		statuses[v.GetId()] = convertStatus(v.GetStatus())
+	}
+	return statuses, nil
+}
+
+// GetUserTier the tier of the user not found is empty
+func (c *GRPCChecker) GetUserTier(ctx context.Context, userID int64) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+	reply, err := c.client.BatchGetUsers(ctx, &userpb.BatchGetUsersRequest{Ids: []int64{userID}})
+	if err != nil {
+		return "", errors.Wrap(err, "[usercheck] get user tier err")
+	}
+	for _, v := range reply.GetUsers() {
+		if v.GetId() == userID {
+			return v.GetTier(), nil
+		}
+	}
+	return "", nil
+}
+
+func convertStatus(status userpb.StatusType) Status {
+	switch status {
+	case userpb.StatusType_NORMAL:
+		return StatusNormal
+	case userpb.StatusType_BANNED:
+		return StatusBanned
+	case userpb.StatusType_DELETED:
+		return StatusDeactivated
+	default:
+		// the new status of the user service is not followable until it is known
+		return StatusDeactivated
+	}
+}
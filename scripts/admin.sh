@@ -83,6 +83,23 @@ function stop()
   fi
 }
 
+# 管理接口, 通过 grpcurl 调用 RelationAdminService
+# eg: ADMIN_TOKEN=xxx ./scripts/admin.sh relation-service raw-edges 1 2
+admin_addr=${ADMIN_ADDR:-"localhost:9093"}
+
+function adminCall()
+{
+  if [ "${ADMIN_TOKEN}" == "" ];then
+    echo "ADMIN_TOKEN is required"
+    exit 1
+  fi
+
+  grpcurl -plaintext -import-path ${base_dir} -import-path ${base_dir}/third_party \
+    -proto api/relation/v1/relation_admin.proto \
+    -H "authorization: Bearer ${ADMIN_TOKEN}" \
+    -d "$2" ${admin_addr} relation.v1.RelationAdminService/$1
+}
+
 case "$2" in
   'start')
     start
@@ -96,8 +113,34 @@ case "$2" in
   'restart')
     stop && start
     ;;
+  'raw-edges')
+    adminCall GetRawEdges "{\"user_id\": $3, \"target_uid\": $4}"
+    ;;
+  'force-follow')
+    adminCall ForceFollow "{\"user_id\": $3, \"followed_uid\": $4, \"reason\": \"$5\"}"
+    ;;
+  'force-unfollow')
+    adminCall ForceUnfollow "{\"user_id\": $3, \"followed_uid\": $4, \"reason\": \"$5\"}"
+    ;;
+  'recompute-counters')
+    adminCall RecomputeUserCounters "{\"user_id\": $3}"
+    ;;
+  'purge-cache')
+    adminCall PurgeUserCache "{\"user_id\": $3}"
+    ;;
+  'merge-relations')
+    adminCall MergeUserRelations "{\"from_uid\": $3, \"to_uid\": $4, \"reason\": \"$5\"}"
+    ;;
+  'merge-status')
+    adminCall GetUserRelationMerge "{\"merge_id\": $3}"
+    ;;
   *)
     echo "usage: $0 {start|stop|restart|status}"
+    echo "       $0 raw-edges user_id target_uid"
+    echo "       $0 {force-follow|force-unfollow} user_id target_uid reason"
+    echo "       $0 {recompute-counters|purge-cache} user_id"
+    echo "       $0 merge-relations from_uid to_uid reason"
+    echo "       $0 merge-status merge_id"
     exit 0
     ;;
 esac
\ No newline at end of file